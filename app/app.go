@@ -1,9 +1,15 @@
 package app
 
 import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
 	"github.com/dunstorm/pm2-go/grpc/client"
 	pb "github.com/dunstorm/pm2-go/proto"
-	"github.com/dunstorm/pm2-go/shared"
 	"github.com/dunstorm/pm2-go/utils"
 	"github.com/rs/zerolog"
 )
@@ -11,20 +17,61 @@ import (
 type App struct {
 	client *client.Client
 	logger *zerolog.Logger
+
+	// address of the local daemon
+	address string
 }
 
 func New() *App {
 	logger := utils.NewLogger()
-	client, err := client.New(50051)
+	// an invalid config is reported by the daemon
+	config, err := utils.LoadConfig()
+	if err != nil {
+		config = utils.DefaultConfig()
+	}
+	client, err := client.NewLocal(config.GrpcAddress)
 	if err != nil {
 		logger.Fatal().Err(err).Msg("Failed to create client")
 	}
 	return &App{
-		logger: logger,
-		client: client,
+		logger:  logger,
+		client:  client,
+		address: config.GrpcAddress,
 	}
 }
 
+// use the daemon of host or of a context of the client config instead of the local
+// one, host overrides the one of the context
+func (app *App) Connect(host string, contextName string) error {
+	if host == "" && contextName == "" {
+		return nil
+	}
+	context := utils.ClientContext{Host: host}
+	if contextName != "" {
+		config, err := utils.LoadClientConfig()
+		if err != nil {
+			return err
+		}
+		if context, err = config.Context(contextName); err != nil {
+			return err
+		}
+		if host != "" {
+			context.Host = host
+		}
+	}
+	client, err := client.NewRemote(context)
+	if err != nil {
+		return err
+	}
+	app.client = client
+	return nil
+}
+
+// whether the daemon is on another host, commands using its files can't be used
+func (app *App) IsRemote() bool {
+	return app.client.IsRemote()
+}
+
 func (app *App) GetLogger() *zerolog.Logger {
 	return app.logger
 }
@@ -49,12 +96,39 @@ func (app *App) ListProcess() []*pb.Process {
 	return app.client.ListProcess()
 }
 
+// order processes so that each one stops before its dependencies
+func (app *App) StopOrder(processes []*pb.Process) []*pb.Process {
+	byName := make(map[string]*pb.Process, len(processes))
+	names := make([]string, len(processes))
+	for i, p := range processes {
+		byName[p.Name] = p
+		names[i] = p.Name
+	}
+	sorted, err := utils.SortByDependencies(names, func(name string) []string {
+		return byName[name].DependsOn
+	})
+	if err != nil {
+		app.logger.Warn().Msg(err.Error())
+		return processes
+	}
+	ordered := make([]*pb.Process, len(sorted))
+	for i, name := range sorted {
+		ordered[len(sorted)-1-i] = byName[name]
+	}
+	return ordered
+}
+
 func (app *App) FindProcess(name string) *pb.Process {
 	return app.client.FindProcess(name)
 }
 
-func (app *App) StopProcess(index int32) bool {
-	return app.client.StopProcess(index)
+// resolve names, ids, id ranges, namespaces, regexes and label selectors to processes
+func (app *App) ResolveTargets(targets []string, selectors []string) ([]*pb.Process, error) {
+	return app.client.ResolveTargets(targets, selectors)
+}
+
+func (app *App) StopProcess(process *pb.Process) bool {
+	return app.client.StopProcess(process)
 }
 
 func (app *App) StartProcess(newProcess *pb.Process) *pb.Process {
@@ -74,24 +148,107 @@ func (app *App) StartProcess(newProcess *pb.Process) *pb.Process {
 	})
 }
 
+// spawn a process inside the daemon, so that it owns its output streams
+func (app *App) SpawnProcess(request *pb.SpawnProcessRequest) *pb.Process {
+	app.prepareSpawnRequest(request)
+	return app.client.SpawnProcess(request).GetProcess()
+}
+
+// fill the name and resolve the paths of a spawn request
+func (app *App) prepareSpawnRequest(request *pb.SpawnProcessRequest) {
+	if request.Name == "" {
+		request.Name = strings.ToLower(request.ExecutablePath)
+	}
+
+	// paths are resolved from where the cli is running
+	if request.Cwd == "" {
+		request.Cwd, _ = os.Getwd()
+	} else if !filepath.IsAbs(request.Cwd) {
+		request.Cwd, _ = filepath.Abs(request.Cwd)
+	}
+	if request.ExecutablePath != "" {
+		executablePath, err := exec.LookPath(request.ExecutablePath)
+		if err != nil {
+			app.logger.Fatal().Msg(err.Error())
+		}
+		if !filepath.IsAbs(executablePath) {
+			executablePath, _ = filepath.Abs(executablePath)
+		}
+		request.ExecutablePath = executablePath
+	}
+}
+
 func (app *App) RestartProcess(process *pb.Process) *pb.Process {
-	app.StopProcess(process.Id)
-	newProcess, err := shared.SpawnNewProcess(shared.SpawnParams{
-		Name:           process.Name,
-		Args:           process.Args,
-		ExecutablePath: process.ExecutablePath,
-		AutoRestart:    process.AutoRestart,
-		Logger:         app.logger,
-		Cwd:            process.Cwd,
-		Scripts:        process.Scripts,
-		CronRestart:    process.CronRestart,
-	})
-	if err != nil {
-		app.logger.Fatal().Err(err).Msg("Failed to restart process")
+	app.StopProcess(process)
+	return app.SpawnProcess(process.SpawnRequest())
+}
+
+// items applying an action to processes
+func BatchItems(action string, processes []*pb.Process) []*pb.BatchItem {
+	items := make([]*pb.BatchItem, len(processes))
+	for i, process := range processes {
+		items[i] = &pb.BatchItem{Action: action, Target: strconv.Itoa(int(process.Id))}
+	}
+	return items
+}
+
+// apply items in the daemon and log their results. with atomic, a failure rolls
+// back the applied items
+func (app *App) BatchApply(items []*pb.BatchItem, atomic bool) error {
+	for _, item := range items {
+		if item.Spec != nil {
+			app.prepareSpawnRequest(item.Spec)
+		}
+	}
+	response := app.client.BatchApply(&pb.BatchApplyRequest{Items: items, Atomic: atomic})
+
+	failed := 0
+	for _, result := range response.Results {
+		switch {
+		case result.Success && result.Process != nil:
+			app.logger.Info().Msgf("Applied action %s on app [%s](pid: [ %d ]) ✓", result.Action, result.Name, result.Process.Pid)
+		case result.Success:
+			app.logger.Info().Msgf("Applied action %s on app [%s] ✓", result.Action, result.Name)
+		default:
+			failed++
+			app.logger.Error().Msgf("Failed to %s app [%s]: %s", result.Action, result.Name, result.Error)
+		}
 	}
-	newProcess.Id = process.Id
-	process = app.StartProcess(newProcess)
-	return process
+	if response.RolledBack {
+		app.logger.Warn().Msg("Rolled back the applied actions")
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d actions failed", failed, len(items))
+	}
+	return nil
+}
+
+func (app *App) SignalProcess(ids []int32, signal string, group bool) *pb.SignalProcessResponse {
+	return app.client.SignalProcess(&pb.SignalProcessRequest{
+		Ids:    ids,
+		Signal: signal,
+		Group:  group,
+	})
+}
+
+func (app *App) ListActions(id int32) []*pb.Action {
+	return app.client.ListActions(id)
+}
+
+func (app *App) TriggerAction(id int32, action string, param string) string {
+	return app.client.TriggerAction(&pb.TriggerActionRequest{
+		Id:     id,
+		Action: action,
+		Param:  param,
+	})
+}
+
+func (app *App) StreamLogs(id int32, lines int, follow bool, onLine func(line *pb.LogLine)) {
+	app.client.StreamLogs(&pb.StreamLogsRequest{Id: id, Lines: int32(lines), Follow: follow}, onLine)
+}
+
+func (app *App) GetMetrics(ids ...int32) []*pb.ProcessMetrics {
+	return app.client.GetMetrics(ids)
 }
 
 func (app *App) DeleteProcess(process *pb.Process) bool {
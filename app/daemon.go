@@ -30,6 +30,14 @@ func isDaemonRunning() bool {
 	return false
 }
 
+// reload the daemon config, if it is running
+func (app *App) ReloadConfig() bool {
+	if !isDaemonRunning() {
+		return false
+	}
+	return app.client.ReloadConfig()
+}
+
 const (
 	MARK_NAME  = "_GO_DAEMON"
 	MARK_VALUE = "1"
@@ -40,7 +48,7 @@ func wasReborn() bool {
 }
 
 func (app *App) SpawnDaemon() {
-	if isDaemonRunning() && !wasReborn() {
+	if app.IsRemote() || (isDaemonRunning() && !wasReborn()) {
 		return
 	}
 
@@ -108,10 +116,10 @@ func (app *App) SpawnDaemon() {
 			return
 		}
 
-		// wait for 50051 port to open with a timeout of 2s
+		// wait for the grpc address to open with a timeout of 2s
 		found := false
 		for i := 0; i < 200; i++ {
-			if utils.IsPortOpen(50051) {
+			if utils.IsAddressOpen(app.address) {
 				found = true
 				break
 			}
@@ -127,6 +135,6 @@ func (app *App) SpawnDaemon() {
 	}
 
 	if wasReborn() {
-		server.New(50051)
+		server.New(app.address)
 	}
 }
@@ -0,0 +1,159 @@
+package app
+
+import (
+	"path/filepath"
+
+	pb "github.com/dunstorm/pm2-go/proto"
+	"github.com/dunstorm/pm2-go/shared"
+	"github.com/dunstorm/pm2-go/utils"
+	"google.golang.org/protobuf/proto"
+)
+
+// an app of an ecosystem file compared with the daemon
+type AppDiff struct {
+	Name string
+	// the app of the file, nil when it's removed
+	Spec *pb.SpawnProcessRequest
+	// the app of the daemon, nil when it's added
+	Process *pb.Process
+	// the fields which differ, for changed apps
+	Changes []string
+}
+
+// FileDiff is what applying an ecosystem file changes in the daemon
+type FileDiff struct {
+	Added     []AppDiff
+	Changed   []AppDiff
+	Unchanged []AppDiff
+	// apps of the namespaces of the file which are missing from it
+	Removed []AppDiff
+
+	// the added and changed apps, in start order
+	toStart []*pb.SpawnProcessRequest
+}
+
+// check if applying the file starts or restarts nothing
+func (d *FileDiff) Empty() bool {
+	return len(d.toStart) == 0
+}
+
+// compare the apps of a file with the ones of the daemon
+func (app *App) DiffFile(filePath string, namespace string) (*FileDiff, error) {
+	return app.diffFile(filePath, namespace, app.ListProcess())
+}
+
+// compare the apps of a file with processes
+func (app *App) diffFile(filePath string, namespace string, processes []*pb.Process) (*FileDiff, error) {
+	payload, err := readFileApps(filePath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]*pb.Process, len(processes))
+	for _, p := range processes {
+		byName[p.Name] = p
+	}
+
+	diff := &FileDiff{}
+	names := make(map[string]bool, len(payload))
+	namespaces := make(map[string]bool)
+	for _, p := range payload {
+		spec, err := p.spawnRequest()
+		if err != nil {
+			return nil, err
+		}
+		app.prepareSpawnRequest(spec)
+		spec.Namespace = defaultNamespace(spec.Namespace)
+		names[spec.Name] = true
+		namespaces[spec.Namespace] = true
+
+		process := byName[spec.Name]
+		if process == nil {
+			diff.Added = append(diff.Added, AppDiff{Name: spec.Name, Spec: spec})
+			diff.toStart = append(diff.toStart, spec)
+			continue
+		}
+		changes := specChanges(spec, process)
+		if len(changes) == 0 {
+			diff.Unchanged = append(diff.Unchanged, AppDiff{Name: spec.Name, Spec: spec, Process: process})
+			continue
+		}
+		diff.Changed = append(diff.Changed, AppDiff{Name: spec.Name, Spec: spec, Process: process, Changes: changes})
+		diff.toStart = append(diff.toStart, spec)
+	}
+
+	for _, p := range processes {
+		if !names[p.Name] && namespaces[defaultNamespace(p.Namespace)] {
+			diff.Removed = append(diff.Removed, AppDiff{Name: p.Name, Process: p})
+		}
+	}
+	return diff, nil
+}
+
+// apps without a namespace are in the default one
+func defaultNamespace(namespace string) string {
+	if namespace == "" {
+		return shared.DefaultNamespace
+	}
+	return namespace
+}
+
+// the fields of a spec which differ from the running process, by their names in
+// the spawn request. the log files are compared once resolved like the daemon does
+func specChanges(spec *pb.SpawnProcessRequest, process *pb.Process) []string {
+	wanted := proto.Clone(spec).(*pb.SpawnProcessRequest)
+	wanted.OutFile, wanted.ErrorFile, wanted.LogFile = shared.LogFilePaths(wanted, daemonDirectory(process))
+	current := process.SpawnRequest()
+	current.Namespace = defaultNamespace(current.Namespace)
+
+	var changes []string
+	if !proto.Equal(wanted, current) {
+		wantedFields, currentFields := wanted.ProtoReflect(), current.ProtoReflect()
+		fields := wantedFields.Descriptor().Fields()
+		for i := 0; i < fields.Len(); i++ {
+			field := fields.Get(i)
+			// an unset optional field differs from its zero value
+			if wantedFields.Has(field) != currentFields.Has(field) || !wantedFields.Get(field).Equal(currentFields.Get(field)) {
+				changes = append(changes, string(field.Name()))
+			}
+		}
+	}
+	if process.ProcStatus.GetStatus() != "online" {
+		changes = append(changes, "not running")
+	}
+	return changes
+}
+
+// the main directory of the daemon running a process, its pid file is in there
+func daemonDirectory(process *pb.Process) string {
+	if process.PidFilePath == "" {
+		return utils.GetMainDirectory()
+	}
+	return filepath.Dir(filepath.Dir(process.PidFilePath))
+}
+
+// start the added and changed apps of a diff in one batch, and with prune
+// delete the removed ones first
+func (app *App) ApplyDiff(diff *FileDiff, prune bool, atomic bool) error {
+	items := app.diffItems(diff, prune)
+	if len(items) == 0 {
+		return nil
+	}
+	return app.BatchApply(items, atomic)
+}
+
+// the batch items applying a diff
+func (app *App) diffItems(diff *FileDiff, prune bool) []*pb.BatchItem {
+	var items []*pb.BatchItem
+	if prune && len(diff.Removed) > 0 {
+		removed := make([]*pb.Process, len(diff.Removed))
+		for i, d := range diff.Removed {
+			removed[i] = d.Process
+		}
+		items = BatchItems(shared.BatchDelete, app.StopOrder(removed))
+	}
+	for _, spec := range diff.toStart {
+		items = append(items, &pb.BatchItem{Action: shared.BatchStart, Spec: spec})
+	}
+	return items
+}
@@ -0,0 +1,201 @@
+package app
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	pb "github.com/dunstorm/pm2-go/proto"
+	"github.com/dunstorm/pm2-go/shared"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/proto"
+)
+
+// the main directory of the daemon of the tests
+const testDaemonDirectory = "/home/daemon/.pm2-go"
+
+func newTestApp() *App {
+	logger := zerolog.Nop()
+	return &App{logger: &logger}
+}
+
+// a process as the daemon spawns it from a spec
+func spawnedProcess(spec *pb.SpawnProcessRequest, status string) *pb.Process {
+	outFile, errFile, logFile := shared.LogFilePaths(spec, testDaemonDirectory)
+	return &pb.Process{
+		Name:             spec.Name,
+		Args:             spec.Args,
+		ExecutablePath:   spec.ExecutablePath,
+		AutoRestart:      spec.AutoRestart,
+		Cwd:              spec.Cwd,
+		Scripts:          spec.Scripts,
+		CronRestart:      spec.CronRestart,
+		LogTransformers:  spec.LogTransformers,
+		LogFilePath:      outFile,
+		ErrFilePath:      errFile,
+		CombinedFilePath: logFile,
+		PidFilePath:      filepath.Join(testDaemonDirectory, "pids", spec.Name+".pid"),
+		MergeLogs:        spec.MergeLogs,
+		LogRotate:        spec.LogRotate,
+		HealthCheck:      spec.HealthCheck,
+		DependsOn:        spec.DependsOn,
+		Hooks:            spec.Hooks,
+		User:             spec.User,
+		Group:            spec.Group,
+		Umask:            spec.Umask,
+		Limits:           spec.Limits,
+		TreeKill:         spec.TreeKill,
+		Namespace:        spec.Namespace,
+		Labels:           spec.Labels,
+		Env:              spec.Env,
+		ProcStatus:       &pb.ProcStatus{Status: status},
+	}
+}
+
+func TestSpecChanges(t *testing.T) {
+	base := &pb.SpawnProcessRequest{
+		Name:           "api",
+		ExecutablePath: "/usr/bin/api",
+		Args:           []string{"--port", "80"},
+		Cwd:            "/srv/api",
+		AutoRestart:    true,
+		Namespace:      shared.DefaultNamespace,
+		Env:            map[string]string{"MODE": "production"},
+	}
+	treeKill := false
+	cases := []struct {
+		name     string
+		change   func(spec *pb.SpawnProcessRequest)
+		status   string
+		expected []string
+	}{
+		{"unchanged", func(spec *pb.SpawnProcessRequest) {}, "online", nil},
+		{"stopped", func(spec *pb.SpawnProcessRequest) {}, "stopped", []string{"not running"}},
+		{"args", func(spec *pb.SpawnProcessRequest) { spec.Args = []string{"--port", "81"} }, "online", []string{"args"}},
+		{"autorestart", func(spec *pb.SpawnProcessRequest) { spec.AutoRestart = false }, "online", []string{"auto_restart"}},
+		{"env", func(spec *pb.SpawnProcessRequest) { spec.Env = map[string]string{"MODE": "dev"} }, "online", []string{"env"}},
+		{"out file", func(spec *pb.SpawnProcessRequest) { spec.OutFile = "api.log" }, "online", []string{"out_file"}},
+		{"merged logs", func(spec *pb.SpawnProcessRequest) { spec.MergeLogs = true }, "online", []string{"error_file", "merge_logs"}},
+		{"logrotate", func(spec *pb.SpawnProcessRequest) { spec.LogRotate = &pb.LogRotate{MaxFiles: 3} }, "online", []string{"log_rotate"}},
+		{"health check", func(spec *pb.SpawnProcessRequest) {
+			spec.HealthCheck = &pb.HealthCheck{Type: "tcp", Address: "127.0.0.1:80"}
+		}, "online", []string{"health_check"}},
+		{"depends on", func(spec *pb.SpawnProcessRequest) { spec.DependsOn = []string{"db"} }, "online", []string{"depends_on"}},
+		{"hooks", func(spec *pb.SpawnProcessRequest) { spec.Hooks = &pb.Hooks{PreStart: "make"} }, "online", []string{"hooks"}},
+		{"user and group", func(spec *pb.SpawnProcessRequest) {
+			spec.User = "www"
+			spec.Group = "www"
+		}, "online", []string{"user", "group"}},
+		{"umask", func(spec *pb.SpawnProcessRequest) { spec.Umask = "0027" }, "online", []string{"umask"}},
+		{"limits", func(spec *pb.SpawnProcessRequest) { spec.Limits = &pb.Limits{Memory: 1 << 30} }, "online", []string{"limits"}},
+		{"treekill", func(spec *pb.SpawnProcessRequest) { spec.TreeKill = &treeKill }, "online", []string{"tree_kill"}},
+	}
+	for _, c := range cases {
+		process := spawnedProcess(base, c.status)
+		spec := proto.Clone(base).(*pb.SpawnProcessRequest)
+		c.change(spec)
+		if changes := specChanges(spec, process); !reflect.DeepEqual(changes, c.expected) {
+			t.Errorf("%s: expected changes %v, got %v", c.name, c.expected, changes)
+		}
+	}
+}
+
+func TestDiffFile(t *testing.T) {
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "ecosystem.json")
+	content := `[
+		{"name": "added", "executable_path": "sleep", "args": ["30"], "cwd": "` + dir + `"},
+		{"name": "changed", "executable_path": "sleep", "args": ["60"], "cwd": "` + dir + `"},
+		{"name": "unchanged", "executable_path": "sleep", "args": ["30"], "cwd": "` + dir + `"}
+	]`
+	if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	sleep, err := exec.LookPath("sleep")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	spec := func(name string, namespace string) *pb.SpawnProcessRequest {
+		return &pb.SpawnProcessRequest{Name: name, ExecutablePath: sleep, Args: []string{"30"}, Cwd: dir, Namespace: namespace}
+	}
+	processes := []*pb.Process{
+		spawnedProcess(spec("changed", shared.DefaultNamespace), "online"),
+		spawnedProcess(spec("unchanged", shared.DefaultNamespace), "online"),
+		// apps saved before namespaces have none, they are in the default one
+		spawnedProcess(spec("removed", ""), "online"),
+		spawnedProcess(spec("other", "jobs"), "online"),
+	}
+
+	diff, err := newTestApp().diffFile(filePath, "", processes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := func(diffs []AppDiff) []string {
+		var names []string
+		for _, d := range diffs {
+			names = append(names, d.Name)
+		}
+		return names
+	}
+	expected := map[string][]string{
+		"added":     {"added"},
+		"changed":   {"changed"},
+		"unchanged": {"unchanged"},
+		"removed":   {"removed"},
+	}
+	got := map[string][]string{
+		"added":     names(diff.Added),
+		"changed":   names(diff.Changed),
+		"unchanged": names(diff.Unchanged),
+		"removed":   names(diff.Removed),
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+	if changes := diff.Changed[0].Changes; !reflect.DeepEqual(changes, []string{"args"}) {
+		t.Errorf("expected args to change, got %v", changes)
+	}
+}
+
+func TestApplyDiffItems(t *testing.T) {
+	web := &pb.SpawnProcessRequest{Name: "web", ExecutablePath: "/usr/bin/web"}
+	api := &pb.SpawnProcessRequest{Name: "api", ExecutablePath: "/usr/bin/api"}
+	diff := &FileDiff{
+		Added:   []AppDiff{{Name: "web", Spec: web}},
+		Changed: []AppDiff{{Name: "api", Spec: api}},
+		Removed: []AppDiff{
+			{Name: "db", Process: &pb.Process{Id: 1, Name: "db"}},
+			{Name: "cache", Process: &pb.Process{Id: 2, Name: "cache", DependsOn: []string{"db"}}},
+		},
+		toStart: []*pb.SpawnProcessRequest{web, api},
+	}
+
+	cases := []struct {
+		prune    bool
+		expected []string
+	}{
+		{false, []string{"start web", "start api"}},
+		// the removed apps are deleted first, dependents before their dependencies
+		{true, []string{"delete 2", "delete 1", "start web", "start api"}},
+	}
+	for _, c := range cases {
+		var items []string
+		for _, item := range newTestApp().diffItems(diff, c.prune) {
+			target := item.Target
+			if item.Spec != nil {
+				target = item.Spec.Name
+			}
+			items = append(items, item.Action+" "+target)
+		}
+		if !reflect.DeepEqual(items, c.expected) {
+			t.Errorf("prune %v: expected %v, got %v", c.prune, c.expected, items)
+		}
+	}
+
+	if empty := (&FileDiff{Unchanged: []AppDiff{{Name: "web"}}}); !empty.Empty() || len(newTestApp().diffItems(empty, true)) > 0 {
+		t.Error("a diff with only unchanged apps applies something")
+	}
+}
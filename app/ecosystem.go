@@ -0,0 +1,115 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// formats of the ecosystem files
+const (
+	formatJSON = "json"
+	formatYAML = "yaml"
+	formatTOML = "toml"
+)
+
+// files without a known extension are only read up to this size to detect their format
+const maxDetectedFileSize = 1024 * 1024
+
+// the format of an ecosystem file given by its extension, empty if it has none
+func formatByExtension(filePath string) string {
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case ".json":
+		return formatJSON
+	case ".yaml", ".yml":
+		return formatYAML
+	case ".toml":
+		return formatTOML
+	}
+	return ""
+}
+
+// parse the apps of an ecosystem file, yaml and toml have the schema of json
+func parseApps(content []byte, format string) ([]Data, error) {
+	if format != formatJSON {
+		var document any
+		var err error
+		switch format {
+		case formatYAML:
+			err = yaml.Unmarshal(content, &document)
+		case formatTOML:
+			table := map[string]any{}
+			_, err = toml.Decode(string(content), &table)
+			document = table
+		default:
+			return nil, fmt.Errorf("unknown format %s", format)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %v", format, err)
+		}
+		if content, err = json.Marshal(document); err != nil {
+			return nil, fmt.Errorf("invalid %s: %v", format, err)
+		}
+	}
+
+	var payload []Data
+	if err := tryToParseApps(content, &payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// the format of an ecosystem file by its content, empty if it doesn't hold apps
+func detectFormat(content []byte) string {
+	for _, format := range []string{formatJSON, formatTOML, formatYAML} {
+		payload, err := parseApps(content, format)
+		if err != nil || len(payload) == 0 {
+			continue
+		}
+		apps := true
+		for _, p := range payload {
+			apps = apps && p.ExecutablePath != ""
+		}
+		if apps {
+			return format
+		}
+	}
+	return ""
+}
+
+// check if a file is an ecosystem file, by its extension or else by its content.
+// executables are never detected, they are scripts to start
+func IsEcosystemFile(filePath string) bool {
+	info, err := os.Stat(filePath)
+	if err != nil || !info.Mode().IsRegular() {
+		return false
+	}
+	if formatByExtension(filePath) != "" {
+		return true
+	}
+	if info.Mode()&0111 != 0 || info.Size() > maxDetectedFileSize {
+		return false
+	}
+	content, err := os.ReadFile(filePath)
+	return err == nil && detectFormat(content) != ""
+}
+
+// read the apps of a json, yaml or toml ecosystem file
+func readEcosystemFile(filePath string) ([]Data, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	format := formatByExtension(filePath)
+	if format == "" {
+		if format = detectFormat(content); format == "" {
+			return nil, fmt.Errorf("%s is not a json, yaml or toml ecosystem file", filePath)
+		}
+	}
+	return parseApps(content, format)
+}
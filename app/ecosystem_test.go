@@ -0,0 +1,68 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestReadEcosystemFileFormats(t *testing.T) {
+	expected, err := readEcosystemFile("../examples/ecosystem.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, filePath := range []string{"../examples/ecosystem.yaml", "../examples/ecosystem.toml"} {
+		payload, err := readEcosystemFile(filePath)
+		if err != nil {
+			t.Fatalf("%s: %v", filePath, err)
+		}
+		if !reflect.DeepEqual(payload, expected) {
+			t.Errorf("%s: expected %+v, got %+v", filePath, expected, payload)
+		}
+	}
+}
+
+func TestDetectFormat(t *testing.T) {
+	cases := map[string]string{
+		`[{"name": "api", "executable_path": "./api"}]`:         formatJSON,
+		"[[apps]]\nname = \"api\"\nexecutable_path = \"./api\"": formatTOML,
+		"- name: api\n  executable_path: ./api\n":               formatYAML,
+		"apps:\n  - name: api\n    executable_path: ./api\n":    formatYAML,
+		"#!/bin/sh\necho api\n":                                 "",
+		"name: api\n":                                           "",
+		`{"name": "api"}`:                                       "",
+	}
+	for content, format := range cases {
+		if detected := detectFormat([]byte(content)); detected != format {
+			t.Errorf("expected %q for %q, got %q", format, content, detected)
+		}
+	}
+}
+
+func TestIsEcosystemFile(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name string, content string, mode os.FileMode) string {
+		filePath := filepath.Join(dir, name)
+		if err := os.WriteFile(filePath, []byte(content), mode); err != nil {
+			t.Fatal(err)
+		}
+		return filePath
+	}
+	apps := "- name: api\n  executable_path: ./api\n"
+
+	if !IsEcosystemFile(write("apps.yml", apps, 0644)) {
+		t.Error("expected a file with a known extension to be an ecosystem file")
+	}
+	if !IsEcosystemFile(write("apps", apps, 0644)) {
+		t.Error("expected a file holding apps to be an ecosystem file")
+	}
+	if IsEcosystemFile(write("run", apps, 0755)) {
+		t.Error("expected an executable not to be an ecosystem file")
+	}
+	for _, name := range []string{"a", filepath.Join(dir, "missing.json"), dir} {
+		if IsEcosystemFile(name) {
+			t.Errorf("expected %s not to be an ecosystem file", name)
+		}
+	}
+}
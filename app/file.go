@@ -2,137 +2,367 @@ package app
 
 import (
 	"encoding/json"
-	"os"
+	"fmt"
+	"strings"
 
+	"github.com/aptible/supercronic/cronexpr"
 	pb "github.com/dunstorm/pm2-go/proto"
 	"github.com/dunstorm/pm2-go/shared"
+	"github.com/dunstorm/pm2-go/utils"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 type Data struct {
 	Name           string   `json:"name"`
+	Namespace      string   `json:"namespace"`
 	Args           []string `json:"args"`
 	ExecutablePath string   `json:"executable_path"`
 	AutoRestart    bool     `json:"autorestart"`
 	Cwd            string   `json:"cwd"`
 	Scripts        []string `json:"scripts"`
 	CronRestart    string   `json:"cron_restart"`
+
+	LogTransformers []*pb.LogTransformerSpec `json:"log_transformers"`
+	OutFile         string                   `json:"out_file"`
+	ErrorFile       string                   `json:"error_file"`
+	LogFile         string                   `json:"log_file"`
+	MergeLogs       bool                     `json:"merge_logs"`
+	LogRotate       *LogRotateData           `json:"logrotate"`
+	HealthCheck     *HealthCheckData         `json:"health_check"`
+	DependsOn       []string                 `json:"depends_on"`
+	Labels          map[string]string        `json:"labels"`
+	Env             map[string]string        `json:"env"`
+
+	PreStart    string `json:"pre_start"`
+	PostStart   string `json:"post_start"`
+	PreStop     string `json:"pre_stop"`
+	PostStop    string `json:"post_stop"`
+	HookTimeout string `json:"hook_timeout"`
+
+	User  string `json:"user"`
+	Group string `json:"group"`
+	Umask string `json:"umask"`
+
+	Limits   *LimitsData `json:"limits"`
+	TreeKill *bool       `json:"treekill"`
+}
+
+// per app overrides of the log rotation config
+type LogRotateData struct {
+	Enabled  *bool  `json:"enabled"`
+	Size     string `json:"size"`
+	MaxFiles int32  `json:"max_files"`
+	Interval string `json:"interval"`
+	Compress *bool  `json:"compress"`
+	MaxAge   string `json:"max_age"`
+}
+
+func (l *LogRotateData) toProto() (*pb.LogRotate, error) {
+	if l == nil {
+		return nil, nil
+	}
+	logRotate := &pb.LogRotate{
+		Enabled:  l.Enabled,
+		MaxFiles: l.MaxFiles,
+		Interval: l.Interval,
+		Compress: l.Compress,
+		MaxAge:   l.MaxAge,
+	}
+	if l.Size != "" {
+		size, err := utils.ParseSize(l.Size)
+		if err != nil {
+			return nil, err
+		}
+		logRotate.Size = int64(size)
+	}
+	if l.Interval != "" {
+		if _, err := cronexpr.Parse(l.Interval); err != nil {
+			return nil, fmt.Errorf("invalid interval %q: %v", l.Interval, err)
+		}
+	}
+	if l.MaxAge != "" {
+		if _, err := utils.ParseDuration(l.MaxAge); err != nil {
+			return nil, fmt.Errorf("invalid max_age %q: %v", l.MaxAge, err)
+		}
+	}
+	return logRotate, nil
+}
+
+// health check of an app, durations are e.g. 10s, 1m
+type HealthCheckData struct {
+	Type        string `json:"type"`
+	Url         string `json:"url"`
+	Status      int32  `json:"status"`
+	Address     string `json:"address"`
+	Command     string `json:"command"`
+	Interval    string `json:"interval"`
+	Timeout     string `json:"timeout"`
+	Retries     int32  `json:"retries"`
+	GracePeriod string `json:"grace_period"`
+	Restart     bool   `json:"restart"`
+}
+
+func (h *HealthCheckData) toProto() (*pb.HealthCheck, error) {
+	if h == nil {
+		return nil, nil
+	}
+	healthCheck := &pb.HealthCheck{
+		Type:    h.Type,
+		Url:     h.Url,
+		Status:  h.Status,
+		Address: h.Address,
+		Command: h.Command,
+		Retries: h.Retries,
+		Restart: h.Restart,
+	}
+	durations := []struct {
+		name  string
+		value string
+		field **durationpb.Duration
+	}{
+		{"interval", h.Interval, &healthCheck.Interval},
+		{"timeout", h.Timeout, &healthCheck.Timeout},
+		{"grace_period", h.GracePeriod, &healthCheck.GracePeriod},
+	}
+	for _, d := range durations {
+		if d.value == "" {
+			continue
+		}
+		duration, err := utils.ParseDuration(d.value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %v", d.name, d.value, err)
+		}
+		*d.field = durationpb.New(duration)
+	}
+	if err := shared.ValidateHealthCheck(healthCheck); err != nil {
+		return nil, err
+	}
+	return shared.HealthCheckWithDefaults(healthCheck), nil
+}
+
+// resource limits of an app, sizes are e.g. 512M or unlimited
+type LimitsData struct {
+	Nofile *int64  `json:"nofile"`
+	Core   string  `json:"core"`
+	Nproc  *int64  `json:"nproc"`
+	As     string  `json:"as"`
+	Cpu    float64 `json:"cpu"`
+	Memory string  `json:"memory"`
+}
+
+// parse a size limit, unset if empty and -1 if unlimited
+func parseLimitSize(name string, value string) (*int64, error) {
+	if value == "" {
+		return nil, nil
+	}
+	limit := int64(-1)
+	if value != "unlimited" {
+		size, err := utils.ParseSize(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %v", name, err)
+		}
+		limit = int64(size)
+	}
+	return &limit, nil
 }
 
-func readFileJson(filePath string) ([]Data, error) {
-	// read file
-	content, err := os.ReadFile(filePath)
+func (l *LimitsData) toProto() (*pb.Limits, error) {
+	if l == nil {
+		return nil, nil
+	}
+	limits := &pb.Limits{
+		Nofile: l.Nofile,
+		Nproc:  l.Nproc,
+		Cpu:    l.Cpu,
+	}
+	var err error
+	if limits.Core, err = parseLimitSize("core", l.Core); err != nil {
+		return nil, err
+	}
+	if limits.As, err = parseLimitSize("as", l.As); err != nil {
+		return nil, err
+	}
+	memory, err := parseLimitSize("memory", l.Memory)
 	if err != nil {
 		return nil, err
 	}
+	if memory != nil && *memory > 0 {
+		limits.Memory = *memory
+	}
+	if err := shared.ValidateLimits(limits); err != nil {
+		return nil, err
+	}
+	return limits, nil
+}
+
+func (p Data) hooks() (*pb.Hooks, error) {
+	if p.PreStart == "" && p.PostStart == "" && p.PreStop == "" && p.PostStop == "" {
+		return nil, nil
+	}
+	hooks := &pb.Hooks{
+		PreStart:  p.PreStart,
+		PostStart: p.PostStart,
+		PreStop:   p.PreStop,
+		PostStop:  p.PostStop,
+	}
+	if p.HookTimeout != "" {
+		timeout, err := utils.ParseDuration(p.HookTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hook_timeout %q: %v", p.HookTimeout, err)
+		}
+		hooks.Timeout = durationpb.New(timeout)
+	}
+	if err := shared.ValidateHooks(hooks); err != nil {
+		return nil, err
+	}
+	return hooks, nil
+}
 
-	var payload []Data
-	err = tryToParseApps(content, &payload)
+func (p Data) spawnRequest() (*pb.SpawnProcessRequest, error) {
+	logRotate, err := p.LogRotate.toProto()
+	if err != nil {
+		return nil, fmt.Errorf("invalid logrotate of app [%s]: %v", p.Name, err)
+	}
+	healthCheck, err := p.HealthCheck.toProto()
+	if err != nil {
+		return nil, fmt.Errorf("invalid health_check of app [%s]: %v", p.Name, err)
+	}
+	hooks, err := p.hooks()
+	if err != nil {
+		return nil, fmt.Errorf("invalid hooks of app [%s]: %v", p.Name, err)
+	}
+	limits, err := p.Limits.toProto()
+	if err != nil {
+		return nil, fmt.Errorf("invalid limits of app [%s]: %v", p.Name, err)
+	}
+	if p.Umask != "" {
+		if _, err := shared.ParseUmask(p.Umask); err != nil {
+			return nil, fmt.Errorf("invalid umask of app [%s]: %v", p.Name, err)
+		}
+	}
+	return &pb.SpawnProcessRequest{
+		Name:            p.Name,
+		Args:            p.Args,
+		ExecutablePath:  p.ExecutablePath,
+		AutoRestart:     p.AutoRestart,
+		Cwd:             p.Cwd,
+		Scripts:         p.Scripts,
+		CronRestart:     p.CronRestart,
+		LogTransformers: p.LogTransformers,
+		OutFile:         p.OutFile,
+		ErrorFile:       p.ErrorFile,
+		LogFile:         p.LogFile,
+		MergeLogs:       p.MergeLogs,
+		LogRotate:       logRotate,
+		HealthCheck:     healthCheck,
+		DependsOn:       p.DependsOn,
+		Hooks:           hooks,
+		User:            p.User,
+		Group:           p.Group,
+		Umask:           p.Umask,
+		Limits:          limits,
+		TreeKill:        p.TreeKill,
+		Namespace:       p.Namespace,
+		Labels:          p.Labels,
+		Env:             p.Env,
+	}, nil
+}
+
+// order apps so that each one starts after its dependencies
+func sortApps(payload []Data) ([]Data, error) {
+	apps := make(map[string]Data, len(payload))
+	names := make([]string, len(payload))
+	for i, p := range payload {
+		apps[p.Name] = p
+		names[i] = p.Name
+	}
+	sorted, err := utils.SortByDependencies(names, func(name string) []string {
+		return apps[name].DependsOn
+	})
 	if err != nil {
 		return nil, err
 	}
+	for i, name := range sorted {
+		payload[i] = apps[name]
+	}
 	return payload, nil
 }
 
-func (app *App) StartFile(filePath string) error {
-	payload, err := readFileJson(filePath)
+// read the apps of a file in start order, in namespace if it's set
+func readFileApps(filePath string, namespace string) ([]Data, error) {
+	payload, err := readEcosystemFile(filePath)
 	if err != nil {
-		return err
+		return nil, err
 	}
-
-	for _, p := range payload {
-		var process *pb.Process
-		var err error
-		process = app.FindProcess(p.Name)
-		if process == nil {
-			process, err = shared.SpawnNewProcess(shared.SpawnParams{
-				Name:           p.Name,
-				Args:           p.Args,
-				ExecutablePath: p.ExecutablePath,
-				AutoRestart:    p.AutoRestart,
-				Logger:         app.logger,
-				Cwd:            p.Cwd,
-				Scripts:        p.Scripts,
-				CronRestart:    p.CronRestart,
-			})
-			if err != nil {
-				app.logger.Fatal().Msgf("Error while starting process [%s]", p.Name)
-			}
-			app.AddProcess(process)
-		} else {
-			if process.ProcStatus.Status == "online" {
-				app.logger.Info().Msgf("Applying action restartProcessId on app [%s](pid: [ %d ])", process.Name, process.Pid)
-				app.StopProcess(process.Id)
-			} else {
-				app.logger.Info().Msgf("Applying action startProcessId on app [%s]", process.Name)
-			}
-			newProcess, err := shared.SpawnNewProcess(shared.SpawnParams{
-				Name:           process.Name,
-				Args:           p.Args,
-				ExecutablePath: p.ExecutablePath,
-				AutoRestart:    p.AutoRestart,
-				Logger:         app.logger,
-				Cwd:            p.Cwd,
-				Scripts:        p.Scripts,
-				CronRestart:    p.CronRestart,
-			})
-			if err != nil {
-				app.logger.Fatal().Msgf("Error while starting process [%s]", p.Name)
-			}
-			newProcess.Id = process.Id
-			app.StartProcess(newProcess)
+	if namespace != "" {
+		for i := range payload {
+			payload[i].Namespace = namespace
 		}
 	}
-	return nil
+	return sortApps(payload)
 }
 
-func (app *App) StopFile(filePath string) error {
-	payload, err := readFileJson(filePath)
+// start or restart the apps of a file in one batch, in namespace if it's set
+func (app *App) StartFile(filePath string, namespace string, atomic bool) error {
+	payload, err := readFileApps(filePath, namespace)
 	if err != nil {
 		return err
 	}
 
-	for _, p := range payload {
-		var process *pb.Process = app.FindProcess(p.Name)
-		if process == nil {
-			app.logger.Warn().Msgf("App [%s] not found", p.Name)
-		} else {
-			if process.ProcStatus.Status == "online" {
-				app.logger.Info().Msgf("Applying action stopProcessId on app [%s](pid: [ %d ])", process.Name, process.Pid)
-				app.StopProcess(process.Id)
-			} else {
-				app.logger.Warn().Msgf("App [%s] is not running", p.Name)
-			}
+	items := make([]*pb.BatchItem, len(payload))
+	for i, p := range payload {
+		request, err := p.spawnRequest()
+		if err != nil {
+			return err
+		}
+		if len(p.DependsOn) > 0 {
+			app.logger.Info().Msgf("Waiting for dependencies of app [%s]: %s", p.Name, strings.Join(p.DependsOn, ", "))
 		}
+		items[i] = &pb.BatchItem{Action: shared.BatchStart, Spec: request}
 	}
-	return nil
+	return app.BatchApply(items, atomic)
+}
+
+// stop the apps of a file in one batch, dependents first. with atomic, a failure
+// starts the stopped apps again
+func (app *App) StopFile(filePath string, atomic bool) error {
+	return app.applyFile(filePath, shared.BatchStop, atomic)
 }
 
-func (app *App) DeleteFile(filePath string) error {
-	payload, err := readFileJson(filePath)
+// stop and delete the apps of a file in one batch, dependents first. with atomic,
+// a failure restores the deleted apps
+func (app *App) DeleteFile(filePath string, atomic bool) error {
+	return app.applyFile(filePath, shared.BatchDelete, atomic)
+}
+
+// apply an action to the processes of the apps of a file, in stop order. apps
+// missing from the daemon are skipped
+func (app *App) applyFile(filePath string, action string, atomic bool) error {
+	payload, err := readEcosystemFile(filePath)
 	if err != nil {
 		return err
 	}
 
+	byName := make(map[string]*pb.Process)
+	for _, process := range app.ListProcess() {
+		byName[process.Name] = process
+	}
+	var processes []*pb.Process
 	for _, p := range payload {
-		var process *pb.Process = app.FindProcess(p.Name)
-		if process == nil {
-			app.logger.Warn().Msgf("App [%s] not found", p.Name)
+		if process := byName[p.Name]; process != nil {
+			processes = append(processes, process)
 		} else {
-			if process.ProcStatus.Status == "online" {
-				app.logger.Info().Msgf("Applying action stopProcessId on app [%s](pid: [ %d ])", process.Name, process.Pid)
-				app.StopProcess(process.Id)
-			}
-			app.logger.Info().Msgf("Applying action deleteProcessId on app [%s]", process.Name)
-			app.DeleteProcess(process)
-
-			app.logger.Info().Msgf("[%s] ✓", p.Name)
+			app.logger.Warn().Msgf("App [%s] not found", p.Name)
 		}
 	}
-	return nil
+	if len(processes) == 0 {
+		return nil
+	}
+	return app.BatchApply(BatchItems(action, app.StopOrder(processes)), atomic)
 }
 
 func (app *App) FlushFile(filePath string, flushProcess func(process *pb.Process)) error {
-	payload, err := readFileJson(filePath)
+	payload, err := readEcosystemFile(filePath)
 	if err != nil {
 		return err
 	}
@@ -148,48 +378,13 @@ func (app *App) FlushFile(filePath string, flushProcess func(process *pb.Process
 	return nil
 }
 
-func (app *App) RestoreProcess(allProcesses []*pb.Process) {
-	for _, p := range allProcesses {
-		process := app.FindProcess(p.Name)
-		if process.ProcStatus == nil {
-			process, err := shared.SpawnNewProcess(shared.SpawnParams{
-				Name:           p.Name,
-				Args:           p.Args,
-				ExecutablePath: p.ExecutablePath,
-				AutoRestart:    p.AutoRestart,
-				Logger:         app.logger,
-				Cwd:            p.Cwd,
-				Scripts:        p.Scripts,
-				CronRestart:    p.CronRestart,
-			})
-			if err != nil {
-				app.logger.Fatal().Msgf("Error while restoring process [%s]", p.Name)
-			}
-			app.AddProcess(process)
-		} else {
-			if process.ProcStatus.Status == "online" {
-				app.logger.Info().Msgf("Applying action restartProcessId on app [%s](pid: [ %d ])", process.Name, process.Pid)
-				app.StopProcess(process.Id)
-			} else {
-				app.logger.Info().Msgf("Applying action startProcessId on app [%s]", process.Name)
-			}
-			newProcess, err := shared.SpawnNewProcess(shared.SpawnParams{
-				Name:           process.Name,
-				Args:           p.Args,
-				ExecutablePath: p.ExecutablePath,
-				AutoRestart:    p.AutoRestart,
-				Logger:         app.logger,
-				Cwd:            p.Cwd,
-				Scripts:        p.Scripts,
-				CronRestart:    p.CronRestart,
-			})
-			if err != nil {
-				app.logger.Fatal().Msgf("Error while restoring process [%s]", err.Error())
-			}
-			newProcess.Id = process.Id
-			app.StartProcess(newProcess)
-		}
+// start or restart dumped processes in one batch
+func (app *App) RestoreProcess(allProcesses []*pb.Process, atomic bool) error {
+	items := make([]*pb.BatchItem, len(allProcesses))
+	for i, p := range allProcesses {
+		items[i] = &pb.BatchItem{Action: shared.BatchStart, Spec: p.SpawnRequest()}
 	}
+	return app.BatchApply(items, atomic)
 }
 
 type WithAppsField struct {
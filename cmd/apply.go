@@ -0,0 +1,51 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// applyCmd represents the apply command
+var applyCmd = &cobra.Command{
+	Use:   "apply <json>",
+	Short: "Start and restart the apps of an ecosystem file which changed",
+	Long: `Start the apps of an ecosystem file which are added, and restart the changed ones.
+Unchanged apps keep running, see diff. With --prune, apps of the namespaces of the
+file which are missing from it are deleted`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		master.SpawnDaemon()
+		logger := master.GetLogger()
+
+		namespace, _ := cmd.Flags().GetString("namespace")
+		prune, _ := cmd.Flags().GetBool("prune")
+		atomic, _ := cmd.Flags().GetBool("atomic")
+
+		diff, err := master.DiffFile(args[0], namespace)
+		if err != nil {
+			logger.Fatal().Msg(err.Error())
+		}
+		printDiff(diff, prune)
+		if diff.Empty() && (!prune || len(diff.Removed) == 0) {
+			logger.Info().Msg("Nothing to apply")
+			return
+		}
+
+		err = master.ApplyDiff(diff, prune, atomic)
+		renderProcessList()
+		if err != nil {
+			logger.Fatal().Msg(err.Error())
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(applyCmd)
+
+	applyCmd.Flags().String("namespace", "", "namespace of the apps, overrides the one of the file")
+	applyCmd.Flags().Bool("prune", false, "delete the apps of the namespaces of the file which are missing from it")
+	addAtomicFlag(applyCmd)
+}
@@ -5,12 +5,62 @@ Copyright © 2022 NAME HERE <EMAIL ADDRESS>
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
 
 	"github.com/dunstorm/pm2-go/utils"
+	"github.com/fatih/color"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
 	"github.com/spf13/cobra"
 )
 
+// load config, logging why it can't be loaded
+func loadConfig() (utils.Config, bool) {
+	requireLocal("config")
+	config, err := utils.LoadConfig()
+	if err != nil {
+		master.GetLogger().Error().Msg(err.Error())
+		return config, false
+	}
+	return config, true
+}
+
+// save config and apply it to the running daemon
+func saveConfig(config utils.Config) {
+	logger := master.GetLogger()
+	if err := utils.SaveConfig(config); err != nil {
+		logger.Error().Msgf("Error while saving config: %s", err)
+		return
+	}
+	logger.Debug().Msgf("Config saved")
+
+	if master.ReloadConfig() {
+		logger.Info().Msg("Config applied to the daemon")
+	}
+}
+
+func renderConfig(config utils.Config) {
+	t := table.NewWriter()
+	t.SetOutputMirror(os.Stdout)
+	t.SetStyle(table.StyleLight)
+	t.Style().Format.Header = text.FormatLower
+
+	cyanBold := color.New(color.FgCyan, color.Bold).SprintFunc()
+	t.AppendHeader(table.Row{
+		cyanBold("key"),
+		cyanBold("value"),
+		cyanBold("default"),
+		cyanBold("description"),
+	})
+	for _, key := range utils.ConfigKeys {
+		value, _ := config.Get(key.Name)
+		t.AppendRow(table.Row{key.Name, value, key.Default, key.Description})
+	}
+	t.Render()
+}
+
 // configCmd represents the config command
 var configCmd = &cobra.Command{
 	Use:   "config",
@@ -18,89 +68,128 @@ var configCmd = &cobra.Command{
 	Long: `Set/Get configuration values. For example:
 	
 	pm2-go config set logrotate true
-	pm2-go config get logrotate`,
+	pm2-go config get logrotate
+	pm2-go config unset logrotate
+	pm2-go config list --json`,
+	Run: func(cmd *cobra.Command, args []string) {
+		config, ok := loadConfig()
+		if !ok {
+			return
+		}
+		renderConfig(config)
+	},
+}
+
+var configListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List configuration values",
+	Long: `List configuration values. For example:
+
+	pm2-go config list
+	pm2-go config list --json`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		config, ok := loadConfig()
+		if !ok {
+			return
+		}
+
+		asJson, _ := cmd.Flags().GetBool("json")
+		if !asJson {
+			renderConfig(config)
+			return
+		}
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		encoder.Encode(config)
+	},
+}
+
+var configGetCmd = &cobra.Command{
+	Use:   "get <key>",
+	Short: "Get a configuration value",
+	Long: `Get a configuration value. For example:
+
+	pm2-go config get logrotate_size`,
+	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		// find or create config file
-		utils.FindOrCreateConfigFile()
-
-		// get config
-		config := utils.GetConfig()
-
-		// check if args are set
-		if len(args) == 0 {
-			// print config
-			fmt.Println("log_rotate:", config.LogRotate)
-			fmt.Println("log_rotate_max_files:", config.LogRotateMaxFiles)
-			fmt.Println("log_rotate_size:", config.LogRotateSize)
+		config, ok := loadConfig()
+		if !ok {
+			return
+		}
+
+		value, err := config.Get(args[0])
+		if err != nil {
+			master.GetLogger().Error().Msg(err.Error())
 			return
 		}
+		fmt.Println(value)
 	},
 }
 
 var configSetCmd = &cobra.Command{
-	Use:   "set",
+	Use:   "set <key> <value>",
 	Short: "Set configuration values",
 	Long: `Set configuration values. For example:
 
 	pm2-go config set logrotate true
 	pm2-go config set logrotate_max_files 10
-	pm2-go config set logrotate_size 10M`,
+	pm2-go config set logrotate_size 10M
+	pm2-go config set logrotate_interval "0 0 * * *"
+	pm2-go config set logrotate_compress true
+	pm2-go config set logrotate_mode reopen
+	pm2-go config set logrotate_date_format 2006-01-02
+	pm2-go config set logrotate_max_age 7d`,
+	Args: cobra.ExactArgs(2),
 	Run: func(cmd *cobra.Command, args []string) {
-		// find or create config file
-		utils.FindOrCreateConfigFile()
-
-		// get config
-		config := utils.GetConfig()
-
-		// check if args are set
-		if len(args) < 2 {
-			fmt.Println("Please provide a key and value")
+		config, ok := loadConfig()
+		if !ok {
 			return
 		}
 
 		logger := master.GetLogger()
+		if err := config.Set(args[0], args[1]); err != nil {
+			logger.Error().Msg(err.Error())
+			return
+		}
+		value, _ := config.Get(args[0])
+		logger.Info().Msgf("%s has been set to %s", args[0], value)
 
-		// set config
-		switch args[0] {
-		case "logrotate":
-			// check if value is yes or no
-			config.LogRotate = utils.ParseBool(args[1])
-			logger.Info().Msgf("LogRotate has been set to %v", config.LogRotate)
-		case "logrotate_max_files":
-			maxFiles := utils.ParseInt(args[1])
-			if maxFiles < 0 {
-				logger.Error().Msg("logrotate_max_files must be a positive integer")
-				return
-			}
-			config.LogRotateMaxFiles = maxFiles
-			logger.Info().Msgf("LogRotateMaxFiles has been set to %d", config.LogRotateMaxFiles)
-		case "logrotate_size":
-			config.LogRotateSize = utils.ParseSize(args[1])
-			logger.Info().Msgf("LogRotateSize has been set to %d bytes", config.LogRotateSize)
-		default:
-			logger.Error().Msgf("Unknown key")
-			logger.Info().Msgf("Available keys: logrotate, logrotate_max_files, logrotate_size")
+		saveConfig(config)
+	},
+}
+
+var configUnsetCmd = &cobra.Command{
+	Use:   "unset <key>",
+	Short: "Reset a configuration value to its default",
+	Long: `Reset a configuration value to its default. For example:
+
+	pm2-go config unset logrotate_size`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		config, ok := loadConfig()
+		if !ok {
 			return
 		}
 
-		// save config
-		utils.SaveConfig(config)
+		logger := master.GetLogger()
+		if err := config.Unset(args[0]); err != nil {
+			logger.Error().Msg(err.Error())
+			return
+		}
+		value, _ := config.Get(args[0])
+		logger.Info().Msgf("%s has been reset to %s", args[0], value)
 
-		logger.Debug().Msgf("Config saved")
+		saveConfig(config)
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(configCmd)
+	configCmd.AddCommand(configListCmd)
+	configCmd.AddCommand(configGetCmd)
 	configCmd.AddCommand(configSetCmd)
+	configCmd.AddCommand(configUnsetCmd)
 
-	// Here you will define your flags and configuration settings.
-
-	// Cobra supports Persistent Flags which will work for this command
-	// and all subcommands, e.g.:
-	// configCmd.PersistentFlags().String("foo", "", "A help for foo")
-
-	// Cobra supports local flags which will only run when this command
-	// is called directly, e.g.:
-	// configCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+	configListCmd.Flags().Bool("json", false, "print the configuration as JSON")
 }
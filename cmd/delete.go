@@ -5,8 +5,8 @@ Copyright © 2022 NAME HERE <EMAIL ADDRESS>
 package cmd
 
 import (
-	"os"
-
+	"github.com/dunstorm/pm2-go/app"
+	"github.com/dunstorm/pm2-go/shared"
 	"github.com/spf13/cobra"
 )
 
@@ -14,70 +14,44 @@ import (
 var deleteCmd = &cobra.Command{
 	Use:   "delete [options] <name|id|namespace|script|all|json|stdin...>",
 	Short: "Stop and delete a process from pm2 process list",
-	Long:  `Stop and delete a process from pm2 process list`,
+	Long: `Stop and delete a process from pm2 process list.
+
+Targets are ids, id ranges (1-3), names, namespaces, /regexes/ or all. Names
+and namespaces never clash, the daemon rejects an app named after a namespace.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		if len(args) < 1 {
+		if len(args) < 1 && !hasSelectors(cmd) {
 			cmd.Usage()
 			return
 		}
 
 		logger := master.GetLogger()
 
-		if args[0] == "all" {
-			db := master.ListProcess()
-			if len(db) == 0 {
-				logger.Warn().Msg("No processes found")
-				return
-			}
-			for _, process := range db {
-				if process.ProcStatus.Status == "online" {
-					master.GetLogger().Info().Msgf("Applying action stopProcessId on app [%d](pid: [ %d ])", process.Id, process.Pid)
-					master.StopProcess(process.Id)
+		// check if args[0] is an ecosystem file, json, yaml or toml
+		if len(args) == 1 {
+			if app.IsEcosystemFile(args[0]) {
+				atomic, _ := cmd.Flags().GetBool("atomic")
+				err := master.DeleteFile(args[0], atomic)
+				if err == nil {
+					renderProcessList()
+				} else {
+					logger.Fatal().Msg(err.Error())
 				}
-				logger.Info().Msgf("Applying action deleteProcessId on app [%s]", process.Name)
-				master.DeleteProcess(process)
-				logger.Info().Msgf("[%s] ✓", process.Name)
-			}
-			renderProcessList()
-			return
-		}
-
-		// check if args[0] is a file
-		// get file extension
-		// if it's a json file, parse it and start the app
-		if _, err := os.Stat(args[0]); err == nil && args[0][len(args[0])-5:] == ".json" {
-			err = master.DeleteFile(args[0])
-			if err == nil {
-				renderProcessList()
-			} else {
-				logger.Fatal().Msg(err.Error())
+				return
 			}
-			return
 		}
 
-		process := master.FindProcess(args[0])
-		if process.ProcStatus == nil {
-			logger.Error().Msgf("Process or Namespace %s not found", args[0])
+		processes := resolveTargets(cmd, args)
+		if len(processes) == 0 {
 			return
 		}
-
-		// stop process if alive
-		if process.ProcStatus.Status == "online" {
-			logger.Info().Msgf("Applying action stopProcessId on app [%s](pid: [ %d ])", process.Name, process.Pid)
-			master.StopProcess(process.Id)
-		}
-
-		// delete a process
-		logger.Info().Msgf("Applying action deleteProcessId on app [%s]", process.Name)
-		master.DeleteProcess(process)
-		logger.Info().Msgf("[%s] ✓", process.Name)
-
-		renderProcessList()
+		applyAction(cmd, shared.BatchDelete, master.StopOrder(processes))
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(deleteCmd)
+	addSelectorFlag(deleteCmd, "l")
+	addAtomicFlag(deleteCmd)
 
 	// Here you will define your flags and configuration settings.
 
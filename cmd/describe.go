@@ -7,6 +7,7 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/fatih/color"
@@ -59,6 +60,21 @@ var describeCmd = &cobra.Command{
 			cyanBold("name"), process.Name,
 		})
 
+		t.AppendRow(table.Row{
+			cyanBold("namespace"), process.Namespace,
+		})
+
+		if len(process.Labels) > 0 {
+			labels := make([]string, 0, len(process.Labels))
+			for key, value := range process.Labels {
+				labels = append(labels, key+"="+value)
+			}
+			sort.Strings(labels)
+			t.AppendRow(table.Row{
+				cyanBold("labels"), strings.Join(labels, ","),
+			})
+		}
+
 		t.AppendRow(table.Row{
 			cyanBold("restarts"), process.ProcStatus.Restarts,
 		})
@@ -67,10 +83,75 @@ var describeCmd = &cobra.Command{
 			cyanBold("autorestart"), process.AutoRestart,
 		})
 
+		t.AppendRow(table.Row{
+			cyanBold("treekill"), process.KillsTree(),
+		})
+
 		t.AppendRow(table.Row{
 			cyanBold("executable path"), process.ExecutablePath,
 		})
 
+		if process.User != "" {
+			t.AppendRow(table.Row{
+				cyanBold("user"), process.User,
+			})
+		}
+
+		if process.Group != "" {
+			t.AppendRow(table.Row{
+				cyanBold("group"), process.Group,
+			})
+		}
+
+		if process.Umask != "" {
+			t.AppendRow(table.Row{
+				cyanBold("umask"), process.Umask,
+			})
+		}
+
+		if limits := process.Limits; limits != nil {
+			formatLimit := func(value int64) string {
+				if value < 0 {
+					return "unlimited"
+				}
+				return fmt.Sprint(value)
+			}
+			for _, limit := range []struct {
+				name  string
+				value *int64
+			}{
+				{"nofile limit", limits.Nofile},
+				{"core limit", limits.Core},
+				{"nproc limit", limits.Nproc},
+				{"address space limit", limits.As},
+			} {
+				if limit.value != nil {
+					t.AppendRow(table.Row{
+						cyanBold(limit.name), formatLimit(*limit.value),
+					})
+				}
+			}
+			if limits.Cpu > 0 {
+				t.AppendRow(table.Row{
+					cyanBold("cpu limit"), fmt.Sprintf("%v cores", limits.Cpu),
+				})
+			}
+			if limits.Memory > 0 {
+				t.AppendRow(table.Row{
+					cyanBold("memory limit"), fmt.Sprintf("%d bytes", limits.Memory),
+				})
+			}
+			cgroup := process.Cgroup
+			if cgroup == "" && (limits.Cpu > 0 || limits.Memory > 0) {
+				cgroup = "not applied, see the daemon log"
+			}
+			if cgroup != "" {
+				t.AppendRow(table.Row{
+					cyanBold("cgroup"), cgroup,
+				})
+			}
+		}
+
 		t.AppendRow(table.Row{
 			cyanBold("executable args"), strings.Join(process.Args, " "),
 		})
@@ -83,10 +164,103 @@ var describeCmd = &cobra.Command{
 			cyanBold("out log path"), process.LogFilePath,
 		})
 
+		if process.CombinedFilePath != "" {
+			t.AppendRow(table.Row{
+				cyanBold("combined log path"), process.CombinedFilePath,
+			})
+		}
+
+		t.AppendRow(table.Row{
+			cyanBold("merge logs"), process.MergeLogs,
+		})
+
 		t.AppendRow(table.Row{
 			cyanBold("pid file path"), process.PidFilePath,
 		})
 
+		if logRotate := process.LogRotate; logRotate != nil {
+			if logRotate.Enabled != nil {
+				t.AppendRow(table.Row{
+					cyanBold("logrotate"), logRotate.GetEnabled(),
+				})
+			}
+			if logRotate.Size > 0 {
+				t.AppendRow(table.Row{
+					cyanBold("logrotate size"), fmt.Sprintf("%d bytes", logRotate.Size),
+				})
+			}
+			if logRotate.MaxFiles > 0 {
+				t.AppendRow(table.Row{
+					cyanBold("logrotate max files"), logRotate.MaxFiles,
+				})
+			}
+			if logRotate.Interval != "" {
+				t.AppendRow(table.Row{
+					cyanBold("logrotate interval"), logRotate.Interval,
+				})
+			}
+			if logRotate.Compress != nil {
+				t.AppendRow(table.Row{
+					cyanBold("logrotate compress"), logRotate.GetCompress(),
+				})
+			}
+			if logRotate.MaxAge != "" {
+				t.AppendRow(table.Row{
+					cyanBold("logrotate max age"), logRotate.MaxAge,
+				})
+			}
+		}
+
+		if len(process.DependsOn) > 0 {
+			t.AppendRow(table.Row{
+				cyanBold("depends on"), strings.Join(process.DependsOn, ", "),
+			})
+		}
+
+		if hooks := process.Hooks; hooks != nil {
+			for _, hook := range []struct{ name, command string }{
+				{"pre_start hook", hooks.PreStart},
+				{"post_start hook", hooks.PostStart},
+				{"pre_stop hook", hooks.PreStop},
+				{"post_stop hook", hooks.PostStop},
+			} {
+				if hook.command != "" {
+					t.AppendRow(table.Row{
+						cyanBold(hook.name), hook.command,
+					})
+				}
+			}
+		}
+
+		if healthCheck := process.HealthCheck; healthCheck != nil {
+			t.AppendRow(table.Row{
+				cyanBold("health"), renderHealth(process),
+			})
+			t.AppendRow(table.Row{
+				cyanBold("health check"), fmt.Sprintf("%s %s", healthCheck.Type, healthCheck.Target()),
+			})
+			t.AppendRow(table.Row{
+				cyanBold("health check interval"), healthCheck.Interval.AsDuration(),
+			})
+			t.AppendRow(table.Row{
+				cyanBold("health check timeout"), healthCheck.Timeout.AsDuration(),
+			})
+			t.AppendRow(table.Row{
+				cyanBold("health check retries"), healthCheck.Retries,
+			})
+			if healthCheck.GracePeriod != nil {
+				t.AppendRow(table.Row{
+					cyanBold("health check grace period"), healthCheck.GracePeriod.AsDuration(),
+				})
+			}
+			t.AppendRow(table.Row{
+				cyanBold("restart when unhealthy"), healthCheck.Restart,
+			})
+			t.AppendRow(table.Row{
+				cyanBold("health check failures"), process.ProcStatus.HealthFailures,
+			})
+		}
+
 		t.AppendRow(table.Row{
 			cyanBold("cron expression"), process.CronRestart,
 		})
@@ -96,6 +270,23 @@ var describeCmd = &cobra.Command{
 		})
 
 		t.Render()
+
+		metrics := master.GetMetrics(process.Id)
+		if len(metrics) > 0 && len(metrics[0].Metrics) > 0 {
+			fmt.Println()
+			heading("Metrics")
+			fmt.Println()
+
+			m := table.NewWriter()
+			m.SetOutputMirror(os.Stdout)
+			m.SetStyle(table.StyleLight)
+			for _, metric := range metrics[0].Metrics {
+				m.AppendRow(table.Row{
+					cyanBold(metric.Name), formatMetric(metric),
+				})
+			}
+			m.Render()
+		}
 	},
 }
 
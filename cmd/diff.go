@@ -0,0 +1,68 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dunstorm/pm2-go/app"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// print the apps of a diff, removed ones are only deleted with prune
+func printDiff(diff *app.FileDiff, prune bool) {
+	green := color.New(color.FgGreen).SprintFunc()
+	yellow := color.New(color.FgYellow).SprintFunc()
+	red := color.New(color.FgRed).SprintFunc()
+
+	for _, d := range diff.Added {
+		fmt.Println(green("+ " + d.Name))
+	}
+	for _, d := range diff.Changed {
+		fmt.Println(yellow("~ "+d.Name), "("+strings.Join(d.Changes, ", ")+")")
+	}
+	for _, d := range diff.Removed {
+		if prune {
+			fmt.Println(red("- " + d.Name))
+		} else {
+			fmt.Println(red("- "+d.Name), "(kept without --prune)")
+		}
+	}
+	for _, d := range diff.Unchanged {
+		fmt.Println("  " + d.Name)
+	}
+	fmt.Printf("%d added, %d changed, %d removed, %d unchanged\n",
+		len(diff.Added), len(diff.Changed), len(diff.Removed), len(diff.Unchanged))
+}
+
+// diffCmd represents the diff command
+var diffCmd = &cobra.Command{
+	Use:   "diff <json>",
+	Short: "Preview the changes applying an ecosystem file makes",
+	Long: `Compare the apps of an ecosystem file with the ones of the daemon. Apps are added,
+changed when their executable, args, env, cwd, cron_restart, namespace or labels differ
+or when they aren't running, unchanged, or removed when they belong to a namespace of
+the file without being in it`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		master.SpawnDaemon()
+		logger := master.GetLogger()
+
+		namespace, _ := cmd.Flags().GetString("namespace")
+		diff, err := master.DiffFile(args[0], namespace)
+		if err != nil {
+			logger.Fatal().Msg(err.Error())
+		}
+		printDiff(diff, true)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(diffCmd)
+
+	diffCmd.Flags().String("namespace", "", "namespace of the apps, overrides the one of the file")
+}
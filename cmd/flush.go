@@ -5,8 +5,7 @@ Copyright © 2022 NAME HERE <EMAIL ADDRESS>
 package cmd
 
 import (
-	"os"
-
+	"github.com/dunstorm/pm2-go/app"
 	pb "github.com/dunstorm/pm2-go/proto"
 	"github.com/dunstorm/pm2-go/utils"
 	"github.com/spf13/cobra"
@@ -15,63 +14,46 @@ import (
 // flushCmd represents the flush command
 var flushCmd = &cobra.Command{
 	Use:   "flush",
-	Short: "flush [options] [api]",
+	Short: "flush [options] [api...]",
 	Long:  `flush logs`,
 	Run: func(cmd *cobra.Command, args []string) {
+		requireLocal("flush")
 		master.SpawnDaemon()
 
 		logger := master.GetLogger()
 
 		flushProcess := func(process *pb.Process) {
-			logger.Info().Msg(process.LogFilePath)
-			logger.Info().Msg(process.ErrFilePath)
-
-			// remove file contents
-			utils.RemoveFileContents(process.LogFilePath)
-			utils.RemoveFileContents(process.ErrFilePath)
-		}
+			for _, logFile := range process.LogFiles() {
+				logger.Info().Msg(logFile)
 
-		if len(args) == 0 || args[0] == "all" {
-			db := master.ListProcess()
-			if len(db) == 0 {
-				logger.Warn().Msg("No processes found")
-				return
-			}
-			logger.Info().Msg("Flushing:")
-			for _, process := range db {
-				flushProcess(process)
+				// remove file contents
+				utils.RemoveFileContents(logFile)
 			}
-			logger.Info().Msg("Logs flushed")
-			return
 		}
 
-		// check if args[0] is a file
-		// get file extension
-		// if it's a json file, parse it and start the app
-		if _, err := os.Stat(args[0]); err == nil && args[0][len(args[0])-5:] == ".json" {
-			logger.Info().Msg("Flushing:")
-			err = master.FlushFile(args[0], flushProcess)
-			if err != nil {
-				logger.Fatal().Msg(err.Error())
+		// check if args[0] is an ecosystem file, json, yaml or toml
+		if len(args) == 1 {
+			if app.IsEcosystemFile(args[0]) {
+				logger.Info().Msg("Flushing:")
+				err := master.FlushFile(args[0], flushProcess)
+				if err != nil {
+					logger.Fatal().Msg(err.Error())
+				}
+				return
 			}
-			return
 		}
 
-		// if you can find the app in the database, start it
-		process := master.FindProcess(args[0])
-		if process.Name == "" {
-			logger.Error().Msgf("Process or namespace %s not found", args[0])
+		// all the processes without targets
+		processes := resolveTargets(cmd, args)
+		if len(processes) == 0 {
 			return
 		}
 
 		// logs
 		logger.Info().Msg("Flushing:")
-		logger.Info().Msg(process.LogFilePath)
-		logger.Info().Msg(process.ErrFilePath)
-
-		// remove file contents
-		utils.RemoveFileContents(process.LogFilePath)
-		utils.RemoveFileContents(process.ErrFilePath)
+		for _, process := range processes {
+			flushProcess(process)
+		}
 
 		logger.Info().Msg("Logs flushed")
 	},
@@ -79,6 +61,7 @@ var flushCmd = &cobra.Command{
 
 func init() {
 	rootCmd.AddCommand(flushCmd)
+	addSelectorFlag(flushCmd, "l")
 
 	// Here you will define your flags and configuration settings.
 
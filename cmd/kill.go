@@ -17,6 +17,7 @@ var killCmd = &cobra.Command{
 	Short: "Kill daemon",
 	Long:  `Kill daemon`,
 	Run: func(cmd *cobra.Command, args []string) {
+		requireLocal("kill")
 		pid, err := utils.ReadPidFile("daemon.pid")
 		if err != nil {
 			return
@@ -24,12 +25,12 @@ var killCmd = &cobra.Command{
 		logger := master.GetLogger()
 		process, isRunning := utils.IsProcessRunning(pid)
 		if isRunning {
-			procs := master.ListProcess()
+			procs := master.StopOrder(master.ListProcess())
 			if len(procs) > 0 {
 				for _, p := range procs {
 					if p.ProcStatus.Status == "online" {
 						logger.Info().Msgf("Applying action stopProcessId on app [%s](pid: [ %d ])", p.Name, p.Pid)
-						master.StopProcess(p.Id)
+						master.StopProcess(p)
 					}
 				}
 			} else {
@@ -7,98 +7,110 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"sort"
 	"strconv"
 	"sync"
 
+	"github.com/dunstorm/pm2-go/app"
+	pb "github.com/dunstorm/pm2-go/proto"
 	"github.com/dunstorm/pm2-go/utils"
 	"github.com/fatih/color"
-
 	"github.com/spf13/cobra"
 )
 
 // logsCmd represents the logs command
 var logsCmd = &cobra.Command{
-	Use:   "logs [options] [id|name|namespace]",
+	Use:   "logs [options] [id|name|namespace...]",
 	Short: "Stream logs file",
 	Long:  `Stream logs file`,
 	Run: func(cmd *cobra.Command, args []string) {
-		if len(os.Args) < 1 {
-			cmd.Usage()
-			return
-		}
-
 		tail, _ := cmd.Flags().GetInt("lines")
 
-		// check if args[0] is a file
-		// get file extension
-		// if it's a json file, parse it and start the app
-		if _, err := os.Stat(args[0]); err == nil && args[0][len(args[0])-5:] == ".json" {
-			master.StartFile(args[0])
-			return
+		// check if args[0] is an ecosystem file, json, yaml or toml
+		if len(args) == 1 {
+			if app.IsEcosystemFile(args[0]) {
+				master.StartFile(args[0], "", false)
+				return
+			}
 		}
 
 		logger := master.GetLogger()
 
-		// if you can find the app in the database
-		process := master.FindProcess(args[0])
-		if process != nil {
-			logPrefix := strconv.Itoa(int(process.Id)) + "|" + process.Name + "| "
+		// all the processes without targets
+		processes := resolveTargets(cmd, args)
+		if len(processes) == 0 {
+			return
+		}
 
-			green := color.New(color.FgGreen).SprintFunc()
-			red := color.New(color.FgRed).SprintFunc()
+		green := color.New(color.FgGreen).SprintFunc()
+		red := color.New(color.FgRed).SprintFunc()
+		cyanBold := color.New(color.FgCyan, color.Bold)
 
-			cyanBold := color.New(color.FgCyan, color.Bold)
+		// run until every stream ends, local files are tailed indefinitely
+		var wg sync.WaitGroup
+		for _, process := range processes {
+			logPrefix := strconv.Itoa(int(process.Id)) + "|" + process.Name + "| "
 			cyanBold.Printf("[TAILING] Tailing last %d lines for [%s] process (change the value with --lines option)\n", tail, process.Name)
 
-			outLastModified := utils.GetLastModified(process.LogFilePath)
-			errLastModified := utils.GetLastModified(process.ErrFilePath)
+			// the log files of a remote daemon are streamed by it
+			if master.IsRemote() {
+				colors := map[string]func(a ...interface{}) string{"out": green, "err": red}
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					master.StreamLogs(process.Id, tail, true, func(line *pb.LogLine) {
+						fmt.Println(colors[line.Stream](logPrefix), line.Line)
+					})
+				}()
+				continue
+			}
 
-			printStdoutLogs := func() {
-				// print stdout logs
-				color.Green("%s last %d lines", process.LogFilePath, tail)
-				logs, err := utils.GetLogs(process.LogFilePath, tail)
-				if err != nil {
-					logger.Error().Msg(err.Error())
-					return
-				}
-				utils.PrintLogs(logs, logPrefix, green)
+			// stdout & stderr may share the same file, or be disabled
+			type logStream struct {
+				filePath string
+				color    func(a ...interface{}) string
+			}
+			var streams []logStream
+			if process.LogFilePath != os.DevNull {
+				streams = append(streams, logStream{process.LogFilePath, green})
 			}
+			if process.ErrFilePath != os.DevNull && process.ErrFilePath != process.LogFilePath {
+				streams = append(streams, logStream{process.ErrFilePath, red})
+			}
+
+			// print the least recently modified file first
+			sort.SliceStable(streams, func(i, j int) bool {
+				return utils.GetLastModified(streams[i].filePath).Before(utils.GetLastModified(streams[j].filePath))
+			})
 
-			printStderrLogs := func() {
-				// print error logs
-				color.Red("%s last %d lines", process.ErrFilePath, tail)
-				logs, err := utils.GetLogs(process.ErrFilePath, tail)
+			for i, stream := range streams {
+				if i > 0 {
+					fmt.Println()
+				}
+				fmt.Println(stream.color(fmt.Sprintf("%s last %d lines", stream.filePath, tail)))
+				logs, err := utils.GetLogs(stream.filePath, tail)
 				if err != nil {
 					logger.Error().Msg(err.Error())
-					return
+					continue
 				}
-				utils.PrintLogs(logs, logPrefix, red)
+				utils.PrintLogs(logs, logPrefix, stream.color)
 			}
 
-			if errLastModified.Before(outLastModified) {
-				printStderrLogs()
-				fmt.Println()
-				printStdoutLogs()
-			} else {
-				printStdoutLogs()
-				fmt.Println()
-				printStderrLogs()
+			for _, stream := range streams {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					utils.Tail(logPrefix, stream.color, stream.filePath, os.Stdout)
+				}()
 			}
-
-			// to run it indefinitely
-			var wg sync.WaitGroup
-			wg.Add(1)
-			go utils.Tail(logPrefix, green, process.LogFilePath, os.Stdout)
-			go utils.Tail(logPrefix, red, process.ErrFilePath, os.Stdout)
-			wg.Wait()
 		}
-
-		logger.Error().Msgf("Process or Namespace %s not found", args[0])
+		wg.Wait()
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(logsCmd)
+	addSelectorFlag(logsCmd, "")
 
 	// Here you will define your flags and configuration settings.
 
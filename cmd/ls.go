@@ -7,6 +7,7 @@ package cmd
 import (
 	"os"
 
+	pb "github.com/dunstorm/pm2-go/proto"
 	"github.com/fatih/color"
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/jedib0t/go-pretty/v6/text"
@@ -20,6 +21,7 @@ func renderProcessList() {
 	t.AppendHeader(table.Row{
 		cyanBold("#"),
 		cyanBold("name"),
+		cyanBold("namespace"),
 		cyanBold("pid"),
 		cyanBold("ppid"),
 		cyanBold("status"),
@@ -27,6 +29,7 @@ func renderProcessList() {
 		cyanBold("↺"),
 		cyanBold("cpu"),
 		cyanBold("memory"),
+		cyanBold("health"),
 	})
 	t.SetOutputMirror(os.Stdout)
 	t.SetIndexColumn(1)
@@ -44,13 +47,28 @@ func renderProcessList() {
 			p.ProcStatus.Status = redBold(p.ProcStatus.Status)
 		}
 		t.AppendRow(table.Row{
-			p.Id, p.Name, p.Pid, p.ProcStatus.ParentPid, p.ProcStatus.Status, p.ProcStatus.Uptime.AsDuration(), p.ProcStatus.Restarts, p.ProcStatus.Cpu, p.ProcStatus.Memory,
+			p.Id, p.Name, p.Namespace, p.Pid, p.ProcStatus.ParentPid, p.ProcStatus.Status, p.ProcStatus.Uptime.AsDuration(), p.ProcStatus.Restarts, p.ProcStatus.Cpu, p.ProcStatus.Memory, renderHealth(p),
 		})
 	}
 
 	t.Render()
 }
 
+// health of an online process with a health check, colored
+func renderHealth(p *pb.Process) string {
+	health := p.ProcStatus.Health
+	if health == "" || p.Pid == 0 {
+		return "-"
+	}
+	switch health {
+	case "healthy":
+		return color.New(color.FgGreen, color.Bold).Sprint(health)
+	case "unhealthy":
+		return color.New(color.FgRed, color.Bold).Sprint(health)
+	}
+	return color.New(color.FgYellow, color.Bold).Sprint(health)
+}
+
 // lsCmd represents the ls command
 var lsCmd = &cobra.Command{
 	Use:   "ls",
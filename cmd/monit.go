@@ -0,0 +1,87 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/dunstorm/pm2-go/ipc"
+	pb "github.com/dunstorm/pm2-go/proto"
+	"github.com/fatih/color"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/spf13/cobra"
+)
+
+// format a number with at most 2 decimals
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(math.Round(value*100)/100, 'f', -1, 64)
+}
+
+// summary of a metric, e.g. "1523 (12.4/s)" for a counter
+func formatMetric(m *pb.Metric) string {
+	switch m.Type {
+	case ipc.MetricCounter:
+		return fmt.Sprintf("%s (%s/s)", formatNumber(m.Value), formatNumber(m.Rate))
+	case ipc.MetricHistogram:
+		return fmt.Sprintf("mean %s, p50 %s, p95 %s, p99 %s, max %s (%d observations)",
+			formatNumber(m.Value), formatNumber(m.P50), formatNumber(m.P95), formatNumber(m.P99), formatNumber(m.Max), m.Count)
+	}
+	return formatNumber(m.Value)
+}
+
+func renderMetrics(processes []*pb.ProcessMetrics) {
+	t := table.NewWriter()
+	t.SetOutputMirror(os.Stdout)
+	t.SetStyle(table.StyleLight)
+	t.Style().Format.Header = text.FormatLower
+
+	cyanBold := color.New(color.FgCyan, color.Bold).SprintFunc()
+	t.AppendHeader(table.Row{
+		cyanBold("#"),
+		cyanBold("name"),
+		cyanBold("metric"),
+		cyanBold("type"),
+		cyanBold("value"),
+	})
+	for _, process := range processes {
+		for _, m := range process.Metrics {
+			t.AppendRow(table.Row{process.Id, process.Name, m.Name, m.Type, formatMetric(m)})
+		}
+	}
+	t.Render()
+}
+
+// monitCmd represents the monit command
+var monitCmd = &cobra.Command{
+	Use:   "monit",
+	Short: "Monitor processes and their metrics",
+	Long: `Monitor the cpu and memory usage of processes and the metrics they send
+over their ipc channel, refreshed every second. Press Ctrl+C to exit.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		master.SpawnDaemon()
+
+		interval, _ := cmd.Flags().GetDuration("interval")
+		for {
+			metrics := master.GetMetrics()
+			// move to the top left and clear the screen
+			fmt.Print("\033[H\033[2J")
+			renderProcessList()
+			renderMetrics(metrics)
+			time.Sleep(interval)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(monitCmd)
+
+	monitCmd.Flags().DurationP("interval", "i", time.Second, "refresh interval")
+}
@@ -5,8 +5,8 @@ Copyright © 2022 NAME HERE <EMAIL ADDRESS>
 package cmd
 
 import (
-	"os"
-
+	"github.com/dunstorm/pm2-go/app"
+	"github.com/dunstorm/pm2-go/shared"
 	"github.com/spf13/cobra"
 )
 
@@ -14,56 +14,44 @@ import (
 var restartCmd = &cobra.Command{
 	Use:   "restart [options] <id|name|namespace|all|json|stdin...>",
 	Short: "Restart a process",
-	Long:  `Restart a process`,
+	Long: `Restart a process.
+
+Targets are ids, id ranges (1-3), names, namespaces, /regexes/ or all. Names
+and namespaces never clash, the daemon rejects an app named after a namespace.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		if len(args) < 1 {
+		if len(args) < 1 && !hasSelectors(cmd) {
 			cmd.Usage()
 			return
 		}
 
 		logger := master.GetLogger()
 
-		if args[0] == "all" {
-			db := master.ListProcess()
-			if len(db) == 0 {
-				logger.Warn().Msg("No processes found")
+		// check if args[0] is an ecosystem file, json, yaml or toml
+		if len(args) == 1 {
+			if app.IsEcosystemFile(args[0]) {
+				atomic, _ := cmd.Flags().GetBool("atomic")
+				err := master.StartFile(args[0], "", atomic)
+				if err == nil {
+					renderProcessList()
+				} else {
+					logger.Fatal().Msg(err.Error())
+				}
 				return
 			}
-			for _, process := range db {
-				master.GetLogger().Info().Msgf("Applying action restartProcessId on app [%d](pid: [ %d ])", process.Id, process.Pid)
-				master.RestartProcess(process)
-			}
-			renderProcessList()
-			return
-		}
-
-		// check if args[0] is a file
-		// get file extension
-		// if it's a json file, parse it and start the app
-		if _, err := os.Stat(args[0]); err == nil && args[0][len(args[0])-5:] == ".json" {
-			err = master.StartFile(args[0])
-			if err == nil {
-				renderProcessList()
-			} else {
-				logger.Fatal().Msg(err.Error())
-			}
-			return
 		}
 
-		// if you can find the app in the database, start it
-		process := master.FindProcess(args[0])
-		if process.Name != "" {
-			master.RestartProcess(process)
-			renderProcessList()
+		processes := resolveTargets(cmd, args)
+		if len(processes) == 0 {
 			return
-		} else {
-			logger.Error().Msgf("Process or Namespace %s not found", args[0])
 		}
+		applyAction(cmd, shared.BatchRestart, processes)
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(restartCmd)
+	addSelectorFlag(restartCmd, "l")
+	addAtomicFlag(restartCmd)
 
 	// Here you will define your flags and configuration settings.
 
@@ -38,13 +38,18 @@ var restoreCmd = &cobra.Command{
 			return
 		}
 		logger.Info().Msgf("Restoring processes located in %s", dumpFilePath)
-		master.RestoreProcess(allProcesses)
+		atomic, _ := cmd.Flags().GetBool("atomic")
+		err = master.RestoreProcess(allProcesses, atomic)
 		renderProcessList()
+		if err != nil {
+			logger.Fatal().Msg(err.Error())
+		}
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(restoreCmd)
+	addAtomicFlag(restoreCmd)
 
 	// Here you will define your flags and configuration settings.
 
@@ -9,6 +9,7 @@ import (
 	"os"
 
 	app "github.com/dunstorm/pm2-go/app"
+	pb "github.com/dunstorm/pm2-go/proto"
 	"github.com/spf13/cobra"
 )
 
@@ -22,6 +23,23 @@ var rootCmd = &cobra.Command{
 It allows you to keep applications alive forever, to reload them without downtime and to facilitate common system admin tasks.`,
 	// Uncomment the following line if your bare application
 	// has an action associated with it:
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		// the daemon itself always runs locally
+		if daemon, _ := cmd.Flags().GetBool("daemon"); daemon {
+			return
+		}
+		host, _ := cmd.Flags().GetString("host")
+		if host == "" {
+			host = os.Getenv("PM2_GO_HOST")
+		}
+		context, _ := cmd.Flags().GetString("context")
+		if context == "" {
+			context = os.Getenv("PM2_GO_CONTEXT")
+		}
+		if err := master.Connect(host, context); err != nil {
+			master.GetLogger().Fatal().Msgf("Failed to connect: %s", err)
+		}
+	},
 	Run: func(cmd *cobra.Command, args []string) {
 		if daemon, _ := cmd.PersistentFlags().GetBool("daemon"); daemon {
 			master.SpawnDaemon()
@@ -35,6 +53,52 @@ It allows you to keep applications alive forever, to reload them without downtim
 	},
 }
 
+// exit for commands using the files of the local daemon
+func requireLocal(command string) {
+	if master.IsRemote() {
+		master.GetLogger().Fatal().Msgf("%s only works with the local daemon", command)
+	}
+}
+
+// add the label selector flag of the commands taking targets
+func addSelectorFlag(cmd *cobra.Command, shorthand string) {
+	cmd.Flags().StringArrayP("selector", shorthand, nil, "label selector, e.g. tier=backend,env!=dev")
+}
+
+func hasSelectors(cmd *cobra.Command) bool {
+	selectors, _ := cmd.Flags().GetStringArray("selector")
+	return len(selectors) > 0
+}
+
+// resolve the targets and label selectors of a command by the daemon, logs
+// when there are no processes
+func resolveTargets(cmd *cobra.Command, targets []string) []*pb.Process {
+	selectors, _ := cmd.Flags().GetStringArray("selector")
+	processes, err := master.ResolveTargets(targets, selectors)
+	if err != nil {
+		master.GetLogger().Error().Msg(err.Error())
+		return nil
+	}
+	if len(processes) == 0 {
+		master.GetLogger().Warn().Msg("No processes found")
+	}
+	return processes
+}
+
+func addAtomicFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool("atomic", false, "roll back all the apps when one of them fails")
+}
+
+// apply an action to processes in one batch, then list them
+func applyAction(cmd *cobra.Command, action string, processes []*pb.Process) {
+	atomic, _ := cmd.Flags().GetBool("atomic")
+	err := master.BatchApply(app.BatchItems(action, processes), atomic)
+	renderProcessList()
+	if err != nil {
+		master.GetLogger().Fatal().Msg(err.Error())
+	}
+}
+
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
@@ -56,5 +120,7 @@ func init() {
 	rootCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
 	rootCmd.PersistentFlags().BoolP("daemon", "d", false, "Run as daemon")
 	rootCmd.PersistentFlags().BoolP("version", "v", false, "Print pm2 version")
+	rootCmd.PersistentFlags().String("host", "", "Host of a remote daemon, with its port or 50052 (env PM2_GO_HOST)")
+	rootCmd.PersistentFlags().String("context", "", "Context of ~/.pm2-go/client.json to connect to (env PM2_GO_CONTEXT)")
 	logsCmd.PersistentFlags().IntP("lines", "l", 15, "Number of lines to tail")
 }
@@ -0,0 +1,55 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// sendSignalCmd represents the sendSignal command
+var sendSignalCmd = &cobra.Command{
+	Use:   "sendSignal <signal> <name|id|namespace|all...>",
+	Short: "Send a system signal to a process",
+	Long: `Send a system signal to a process. The signal is a name or a number, for example:
+
+	pm2-go sendSignal SIGHUP api
+	pm2-go sendSignal USR1 all
+	pm2-go sendSignal 10 0 --group
+	pm2-go sendSignal SIGTERM -l tier=worker`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) < 2 && !hasSelectors(cmd) {
+			cmd.Usage()
+			return
+		}
+		master.SpawnDaemon()
+		logger := master.GetLogger()
+
+		var ids []int32
+		for _, process := range resolveTargets(cmd, args[1:]) {
+			ids = append(ids, process.Id)
+		}
+		if len(ids) == 0 {
+			return
+		}
+
+		group, _ := cmd.Flags().GetBool("group")
+		response := master.SignalProcess(ids, args[0], group)
+		for _, result := range response.Results {
+			if result.Success {
+				logger.Info().Msgf("Sent %s to app [%s](pid: [ %d ]) ✓", response.Signal, result.Name, result.Pid)
+			} else {
+				logger.Error().Msgf("Failed to send %s to app [%s]: %s", response.Signal, result.Name, result.Error)
+			}
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(sendSignalCmd)
+
+	sendSignalCmd.Flags().BoolP("group", "g", false, "send the signal to the process group of the app")
+	addSelectorFlag(sendSignalCmd, "l")
+}
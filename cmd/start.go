@@ -1,8 +1,11 @@
 package cmd
 
 import (
-	"os"
+	"errors"
 
+	"github.com/dunstorm/pm2-go/app"
+	"github.com/dunstorm/pm2-go/grpc/client"
+	pb "github.com/dunstorm/pm2-go/proto"
 	"github.com/dunstorm/pm2-go/shared"
 	"github.com/spf13/cobra"
 )
@@ -20,6 +23,8 @@ var startCmd = &cobra.Command{
 		}
 
 		logger := master.GetLogger()
+		namespace, _ := cmd.Flags().GetString("namespace")
+		atomic, _ := cmd.Flags().GetBool("atomic")
 
 		if args[0] == "all" {
 			db := master.ListProcess()
@@ -27,19 +32,13 @@ var startCmd = &cobra.Command{
 				logger.Warn().Msg("No processes found")
 				return
 			}
-			for _, process := range db {
-				master.GetLogger().Info().Msgf("Applying action restartProcessId on app [%d](pid: [ %d ])", process.Id, process.Pid)
-				master.RestartProcess(process)
-			}
-			renderProcessList()
+			applyAction(cmd, shared.BatchRestart, db)
 			return
 		}
 
-		// check if args[0] is a file
-		// get file extension
-		// if it's a json file, parse it and start the app
-		if _, err := os.Stat(args[0]); err == nil && args[0][len(args[0])-5:] == ".json" {
-			err = master.StartFile(args[0])
+		// check if args[0] is an ecosystem file, json, yaml or toml
+		if app.IsEcosystemFile(args[0]) {
+			err := master.StartFile(args[0], namespace, atomic)
 			if err == nil {
 				renderProcessList()
 			} else {
@@ -48,26 +47,29 @@ var startCmd = &cobra.Command{
 			return
 		}
 
-		// if you can find the app in the database, start it
-		process := master.FindProcess(args[0])
-		if process != nil {
-			master.GetLogger().Info().Msgf("Applying action startProcessId on app [%d](pid: [ %d ])", process.Id, process.Pid)
-			master.RestartProcess(process)
-			renderProcessList()
+		// if you can find the app or the namespace in the database, start it along
+		// with the other targets. otherwise args[0] is a script taking the others
+		processes, err := master.ResolveTargets(args[:1], nil)
+		if err != nil && !errors.Is(err, client.ErrTargetNotFound) {
+			logger.Fatal().Msg(err.Error())
+		}
+		if len(processes) > 0 {
+			if len(args) > 1 {
+				if processes, err = master.ResolveTargets(args, nil); err != nil {
+					logger.Fatal().Msg(err.Error())
+				}
+			}
+			applyAction(cmd, shared.BatchRestart, processes)
 			return
 		}
 
-		// add process to the database
-		process, err := shared.SpawnNewProcess(shared.SpawnParams{
+		// spawn process inside the daemon
+		process := master.SpawnProcess(&pb.SpawnProcessRequest{
 			ExecutablePath: args[0],
 			Args:           args[1:],
-			Logger:         logger,
+			Namespace:      namespace,
 		})
-		if err != nil {
-			master.GetLogger().Fatal().Msg(err.Error())
-		}
 		master.GetLogger().Info().Msgf("Applying action addProcessName on app [%s](pid: [ %d ])", process.Name, process.Pid)
-		master.AddProcess(process)
 
 		renderProcessList()
 	},
@@ -76,6 +78,9 @@ var startCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(startCmd)
 
+	addAtomicFlag(startCmd)
+	startCmd.Flags().String("namespace", "", "namespace of the started apps, overrides the one of an ecosystem file")
+
 	// Here you will define your flags and configuration settings.
 
 	// Cobra supports Persistent Flags which will work for this command
@@ -15,6 +15,7 @@ var statusCmd = &cobra.Command{
 	Short: "Display status of daemon",
 	Long:  `Display status of daemon`,
 	Run: func(cmd *cobra.Command, args []string) {
+		requireLocal("status")
 		logger := master.GetLogger()
 		pid, err := utils.ReadPidFile("daemon.pid")
 		if err != nil {
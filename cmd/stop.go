@@ -5,8 +5,8 @@ Copyright © 2022 NAME HERE <EMAIL ADDRESS>
 package cmd
 
 import (
-	"os"
-
+	"github.com/dunstorm/pm2-go/app"
+	"github.com/dunstorm/pm2-go/shared"
 	"github.com/spf13/cobra"
 )
 
@@ -14,58 +14,45 @@ import (
 var stopCmd = &cobra.Command{
 	Use:   "stop [options] <id|name|namespace|all|json|stdin...>",
 	Short: "Stop a process",
-	Long:  `Stop a process`,
+	Long: `Stop a process.
+
+Targets are ids, id ranges (1-3), names, namespaces, /regexes/ or all. Names
+and namespaces never clash, the daemon rejects an app named after a namespace.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		master.SpawnDaemon()
-		if len(args) < 1 {
+		if len(args) < 1 && !hasSelectors(cmd) {
 			cmd.Usage()
 			return
 		}
 
 		logger := master.GetLogger()
 
-		if args[0] == "all" {
-			db := master.ListProcess()
-			if len(db) == 0 {
-				logger.Warn().Msg("No processes found")
+		// check if args[0] is an ecosystem file, json, yaml or toml
+		if len(args) == 1 {
+			if app.IsEcosystemFile(args[0]) {
+				atomic, _ := cmd.Flags().GetBool("atomic")
+				err := master.StopFile(args[0], atomic)
+				if err == nil {
+					renderProcessList()
+				} else {
+					logger.Fatal().Msg(err.Error())
+				}
 				return
 			}
-			for _, process := range db {
-				master.GetLogger().Info().Msgf("Applying action stopProcessId on app [%d](pid: [ %d ])", process.Id, process.Pid)
-				master.StopProcess(process.Id)
-			}
-			renderProcessList()
-			return
 		}
 
-		// check if args[0] is a file
-		// get file extension
-		// if it's a json file, parse it and start the app
-		if _, err := os.Stat(args[0]); err == nil && args[0][len(args[0])-5:] == ".json" {
-			err = master.StopFile(args[0])
-			if err == nil {
-				renderProcessList()
-			} else {
-				logger.Fatal().Msg(err.Error())
-			}
+		processes := resolveTargets(cmd, args)
+		if len(processes) == 0 {
 			return
 		}
-
-		// if you can find the app in the database, start it
-		process := master.FindProcess(args[0])
-		if process == nil {
-			logger.Error().Msgf("Process or namespace %s not found", args[0])
-			return
-		}
-
-		logger.Info().Msgf("Applying action stopProcessId on app [%s]", process.Name)
-		master.StopProcess(process.Id)
-		renderProcessList()
+		applyAction(cmd, shared.BatchStop, master.StopOrder(processes))
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(stopCmd)
+	addSelectorFlag(stopCmd, "l")
+	addAtomicFlag(stopCmd)
 
 	// Here you will define your flags and configuration settings.
 
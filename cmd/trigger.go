@@ -0,0 +1,87 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/fatih/color"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/spf13/cobra"
+)
+
+// print the reply of an action, strings unquoted and anything else as indented json
+func printReply(reply string) {
+	if reply == "" {
+		return
+	}
+	var str string
+	if err := json.Unmarshal([]byte(reply), &str); err == nil {
+		fmt.Println(str)
+		return
+	}
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, []byte(reply), "", "  "); err != nil {
+		fmt.Println(reply)
+		return
+	}
+	fmt.Println(indented.String())
+}
+
+// triggerCmd represents the trigger command
+var triggerCmd = &cobra.Command{
+	Use:   "trigger <name|id> [action] [param]",
+	Short: "Trigger an action of a process",
+	Long: `Trigger an action registered by a process and print its reply. Without an
+action, list the actions of the process. For example:
+
+	pm2-go trigger api
+	pm2-go trigger api clear-cache
+	pm2-go trigger api set-level debug`,
+	Args: cobra.RangeArgs(1, 3),
+	Run: func(cmd *cobra.Command, args []string) {
+		master.SpawnDaemon()
+		logger := master.GetLogger()
+
+		process := master.FindProcess(args[0])
+		if process == nil {
+			logger.Error().Msgf("Process %s not found", args[0])
+			return
+		}
+
+		if len(args) == 1 {
+			actions := master.ListActions(process.Id)
+			if len(actions) == 0 {
+				logger.Warn().Msgf("Process %s has no actions", process.Name)
+				return
+			}
+			t := table.NewWriter()
+			t.SetOutputMirror(os.Stdout)
+			t.SetStyle(table.StyleLight)
+			t.Style().Format.Header = text.FormatLower
+			cyanBold := color.New(color.FgCyan, color.Bold).SprintFunc()
+			t.AppendHeader(table.Row{cyanBold("action"), cyanBold("description")})
+			for _, action := range actions {
+				t.AppendRow(table.Row{action.Name, action.Description})
+			}
+			t.Render()
+			return
+		}
+
+		param := ""
+		if len(args) == 3 {
+			param = args[2]
+		}
+		printReply(master.TriggerAction(process.Id, args[1], param))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(triggerCmd)
+}
@@ -3,13 +3,16 @@ module github.com/dunstorm/pm2-go
 go 1.23.0
 
 require (
+	github.com/BurntSushi/toml v1.5.0
 	github.com/aptible/supercronic v0.2.30
 	github.com/fatih/color v1.17.0
+	github.com/fsnotify/fsnotify v1.8.0
 	github.com/jedib0t/go-pretty/v6 v6.5.9
 	github.com/rs/zerolog v1.33.0
 	github.com/spf13/cobra v1.8.1
 	google.golang.org/grpc v1.66.0
 	google.golang.org/protobuf v1.34.2
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
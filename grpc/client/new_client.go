@@ -2,36 +2,131 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
+	"io"
+	"os"
 	"time"
 
 	pb "github.com/dunstorm/pm2-go/proto"
+	"github.com/dunstorm/pm2-go/shared"
 	"github.com/dunstorm/pm2-go/utils"
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
 type Client struct {
-	port int
+	address string
+	// connection to a remote daemon, which has its own token
+	remote      bool
+	remoteToken string
+	tlsConfig   *tls.Config
 
 	logger *zerolog.Logger
 }
 
 func New(port int) (*Client, error) {
+	return NewLocal(fmt.Sprintf("127.0.0.1:%d", port))
+}
+
+// client of the local daemon listening on address
+func NewLocal(address string) (*Client, error) {
 
 	logger := utils.NewLogger()
 	client := &Client{
-		port:   port,
-		logger: logger,
+		address: address,
+		logger:  logger,
+	}
+
+	return client, nil
+}
+
+// client of the daemon of a context, with tls unless it's insecure
+func NewRemote(context utils.ClientContext) (*Client, error) {
+	token, err := context.ReadToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the token: %v", err)
+	}
+	client := &Client{
+		address:     context.Address(),
+		remote:      true,
+		remoteToken: token,
+		logger:      utils.NewLogger(),
+	}
+	if context.Insecure {
+		return client, nil
 	}
 
+	client.tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	if context.TLSCA != "" {
+		content, err := os.ReadFile(context.TLSCA)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(content) {
+			return nil, fmt.Errorf("no certificate found in %s", context.TLSCA)
+		}
+		client.tlsConfig.RootCAs = pool
+	}
+	if context.TLSCert != "" || context.TLSKey != "" {
+		certificate, err := tls.LoadX509KeyPair(context.TLSCert, context.TLSKey)
+		if err != nil {
+			return nil, err
+		}
+		client.tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
 	return client, nil
 }
 
+// whether the daemon is on another host, its files can't be read
+func (c *Client) IsRemote() bool {
+	return c.remote
+}
+
+// token sent with every rpc
+type tokenCredentials string
+
+func (t tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+func (t tokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// the token of PM2_GO_TOKEN, or the one of the daemon. the local one is read on each
+// dial as the daemon creates it once started
+func (c *Client) token() string {
+	if token := os.Getenv("PM2_GO_TOKEN"); token != "" {
+		return token
+	}
+	if c.remote {
+		return c.remoteToken
+	}
+	token, err := utils.ReadToken(utils.TokenFilePath())
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		c.logger.Warn().Msgf("Error while reading the token: %s", err)
+	}
+	return token
+}
+
 func (c *Client) Dial() (*grpc.ClientConn, *pb.ProcessManagerClient) {
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if c.tlsConfig != nil {
+		opts[0] = grpc.WithTransportCredentials(credentials.NewTLS(c.tlsConfig))
+	}
+	if token := c.token(); token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(tokenCredentials(token)))
+	}
 	// Set up a connection to the server.
-	conn, err := grpc.Dial(fmt.Sprintf("127.0.0.1:%d", c.port), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.Dial(c.address, opts...)
 	if err != nil {
 		c.logger.Fatal().Msgf("did not connect: %v", err)
 	}
@@ -66,12 +161,12 @@ func (c *Client) FindProcess(name string) *pb.Process {
 }
 
 // stop process
-func (c *Client) StopProcess(index int32) bool {
-	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+func (c *Client) StopProcess(process *pb.Process) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), stopTimeout(process))
 	defer cancel()
 	conn, manager := c.Dial()
 	defer conn.Close()
-	r, err := (*manager).StopProcess(ctx, &pb.StopProcessRequest{Id: index})
+	r, err := (*manager).StopProcess(ctx, &pb.StopProcessRequest{Id: process.Id})
 	if err != nil {
 		c.logger.Fatal().Msgf("%s", err.Error())
 	}
@@ -91,6 +186,32 @@ func (c *Client) ListProcess() []*pb.Process {
 	return r.GetProcesses()
 }
 
+// returned by ResolveTargets when a target matches no process
+var ErrTargetNotFound = errors.New("target not found")
+
+type notFoundError string
+
+func (e notFoundError) Error() string { return string(e) }
+
+func (notFoundError) Is(target error) bool { return target == ErrTargetNotFound }
+
+// resolve targets and label selectors to processes, unknown targets are an
+// error matching ErrTargetNotFound
+func (c *Client) ResolveTargets(targets []string, selectors []string) ([]*pb.Process, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	conn, manager := c.Dial()
+	defer conn.Close()
+	r, err := (*manager).ResolveTargets(ctx, &pb.ResolveTargetsRequest{Targets: targets, Selectors: selectors})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, notFoundError(status.Convert(err).Message())
+		}
+		return nil, errors.New(status.Convert(err).Message())
+	}
+	return r.GetProcesses(), nil
+}
+
 // update process
 func (c *Client) StartProcess(request *pb.StartProcessRequest) *pb.Process {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
@@ -119,7 +240,7 @@ func (c *Client) DeleteProcess(id int32) bool {
 
 // spawn process inside server
 func (c *Client) SpawnProcess(request *pb.SpawnProcessRequest) *pb.SpawnProcessResponse {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), spawnTimeout(request))
 	defer cancel()
 	conn, manager := c.Dial()
 	defer conn.Close()
@@ -129,3 +250,129 @@ func (c *Client) SpawnProcess(request *pb.SpawnProcessRequest) *pb.SpawnProcessR
 	}
 	return r
 }
+
+// time the daemon may take to stop a process, it runs the pre_stop hook first
+func stopTimeout(process *pb.Process) time.Duration {
+	timeout := 2 * time.Second
+	if process.GetHooks().GetPreStop() != "" {
+		timeout += shared.HookTimeout(process.Hooks)
+	}
+	return timeout
+}
+
+// time the daemon may take to spawn a process
+func spawnTimeout(request *pb.SpawnProcessRequest) time.Duration {
+	timeout := time.Second
+	if len(request.GetDependsOn()) > 0 {
+		// the daemon waits for the dependencies to be ready
+		timeout += time.Minute
+	}
+	if request.GetHooks().GetPreStart() != "" {
+		timeout += shared.HookTimeout(request.Hooks)
+	}
+	return timeout
+}
+
+// time the daemon may take to apply a batch, whatever its size. with atomic,
+// a batch cut short by it is still rolled back by the daemon
+const batchTimeout = 10 * time.Minute
+
+// apply a batch of actions in the daemon
+func (c *Client) BatchApply(request *pb.BatchApplyRequest) *pb.BatchApplyResponse {
+	ctx, cancel := context.WithTimeout(context.Background(), batchTimeout)
+	defer cancel()
+	conn, manager := c.Dial()
+	defer conn.Close()
+	r, err := (*manager).BatchApply(ctx, request)
+	if err != nil {
+		c.logger.Fatal().Msgf("%s", err.Error())
+	}
+	return r
+}
+
+// send a signal to processes
+func (c *Client) SignalProcess(request *pb.SignalProcessRequest) *pb.SignalProcessResponse {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	conn, manager := c.Dial()
+	defer conn.Close()
+	r, err := (*manager).SignalProcess(ctx, request)
+	if err != nil {
+		c.logger.Fatal().Msgf("%s", err.Error())
+	}
+	return r
+}
+
+// list the actions of a process
+func (c *Client) ListActions(id int32) []*pb.Action {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	conn, manager := c.Dial()
+	defer conn.Close()
+	r, err := (*manager).ListActions(ctx, &pb.ListActionsRequest{Id: id})
+	if err != nil {
+		c.logger.Fatal().Msgf("%s", err.Error())
+	}
+	return r.GetActions()
+}
+
+// trigger an action of a process
+func (c *Client) TriggerAction(request *pb.TriggerActionRequest) string {
+	// the daemon waits up to 10 seconds for the reply
+	ctx, cancel := context.WithTimeout(context.Background(), 11*time.Second)
+	defer cancel()
+	conn, manager := c.Dial()
+	defer conn.Close()
+	r, err := (*manager).TriggerAction(ctx, request)
+	if err != nil {
+		c.logger.Fatal().Msgf("%s", err.Error())
+	}
+	return r.GetReply()
+}
+
+// get the metrics of processes, all of them when ids is empty
+func (c *Client) GetMetrics(ids []int32) []*pb.ProcessMetrics {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	conn, manager := c.Dial()
+	defer conn.Close()
+	r, err := (*manager).GetMetrics(ctx, &pb.GetMetricsRequest{Ids: ids})
+	if err != nil {
+		c.logger.Fatal().Msgf("%s", err.Error())
+	}
+	return r.GetProcesses()
+}
+
+// reload daemon config
+func (c *Client) ReloadConfig() bool {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	conn, manager := c.Dial()
+	defer conn.Close()
+	r, err := (*manager).ReloadConfig(ctx, &pb.ReloadConfigRequest{})
+	if err != nil {
+		c.logger.Error().Msgf("%s", err.Error())
+		return false
+	}
+	return r.GetSuccess()
+}
+
+// stream the logs of a process, following them until the daemon or the client stops
+func (c *Client) StreamLogs(request *pb.StreamLogsRequest, onLine func(line *pb.LogLine)) {
+	conn, manager := c.Dial()
+	defer conn.Close()
+	stream, err := (*manager).StreamLogs(context.Background(), request)
+	if err != nil {
+		c.logger.Fatal().Msgf("%s", err.Error())
+	}
+	for {
+		line, err := stream.Recv()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			c.logger.Fatal().Msgf("%s", err.Error())
+		}
+		onLine(line)
+	}
+}
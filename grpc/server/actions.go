@@ -0,0 +1,63 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/dunstorm/pm2-go/proto"
+	"github.com/dunstorm/pm2-go/shared"
+	"google.golang.org/grpc/status"
+)
+
+// time an app has to reply to a triggered action
+const actionTimeout = 10 * time.Second
+
+// get the ipc channel of a running process
+func (api *Handler) ipcChannel(id int32) (*shared.IPCChannel, *pb.Process, error) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	process := api.databaseById[id]
+	if process == nil {
+		return nil, nil, status.Errorf(400, "process not found")
+	}
+	if process.ProcStatus.Status != "online" || process.Pid == 0 {
+		return nil, nil, status.Errorf(400, "process %s is not running", process.Name)
+	}
+	ipc := shared.IPCChannelOf(process.Pid)
+	if ipc == nil {
+		return nil, nil, status.Errorf(400, "process %s has no ipc channel", process.Name)
+	}
+	return ipc, process, nil
+}
+
+// list the actions registered by a process
+func (api *Handler) ListActions(ctx context.Context, in *pb.ListActionsRequest) (*pb.ListActionsResponse, error) {
+	ipc, _, err := api.ipcChannel(in.Id)
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.ListActionsResponse{}
+	for _, action := range ipc.Actions() {
+		response.Actions = append(response.Actions, &pb.Action{
+			Name:        action.Name,
+			Description: action.Description,
+		})
+	}
+	return response, nil
+}
+
+// trigger an action of a process and return its reply
+func (api *Handler) TriggerAction(ctx context.Context, in *pb.TriggerActionRequest) (*pb.TriggerActionResponse, error) {
+	ipc, process, err := api.ipcChannel(in.Id)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, actionTimeout)
+	defer cancel()
+	reply, err := ipc.Trigger(ctx, in.Action, in.Param)
+	if err != nil {
+		return nil, status.Errorf(400, "%s: %v", process.Name, err)
+	}
+	api.logger.Info().Msgf("triggered action %s of process %s", in.Action, process.Name)
+	return &pb.TriggerActionResponse{Reply: string(reply)}, nil
+}
@@ -0,0 +1,108 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/dunstorm/pm2-go/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// role of a client, given by its token
+type role int
+
+const (
+	roleNone role = iota
+	roleReadOnly
+	roleAdmin
+)
+
+// rpcs which don't change the processes, allowed with the read-only token
+var readOnlyMethods = map[string]bool{
+	"FindProcess":       true,
+	"ListProcess":       true,
+	"ListActions":       true,
+	"GetMetrics":        true,
+	"GetProcessHistory": true,
+	"StreamLogs":        true,
+	"ResolveTargets":    true,
+}
+
+// tokens of the daemon, created on start
+type tokens struct {
+	admin    string
+	readOnly string
+}
+
+func loadTokens() (tokens, error) {
+	admin, err := utils.LoadOrCreateToken(utils.TokenFilePath())
+	if err != nil {
+		return tokens{}, fmt.Errorf("failed to load the token: %v", err)
+	}
+	readOnly, err := utils.LoadOrCreateToken(utils.ReadOnlyTokenFilePath())
+	if err != nil {
+		return tokens{}, fmt.Errorf("failed to load the read-only token: %v", err)
+	}
+	return tokens{admin: admin, readOnly: readOnly}, nil
+}
+
+func (t tokens) role(token string) role {
+	switch {
+	case utils.TokenEqual(token, t.admin):
+		return roleAdmin
+	case utils.TokenEqual(token, t.readOnly):
+		return roleReadOnly
+	}
+	return roleNone
+}
+
+// check the token of an rpc. without one, clients are admins unless a token is
+// required, which is always the case for remote clients
+func (api *Handler) checkToken(ctx context.Context, fullMethod string, remote bool) error {
+	token := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 {
+			token, _ = strings.CutPrefix(values[0], "Bearer ")
+		}
+	}
+
+	api.mu.Lock()
+	required := remote || api.config.Auth
+	api.mu.Unlock()
+
+	clientRole := api.tokens.role(token)
+	if clientRole == roleNone {
+		if required || token != "" {
+			return status.Error(codes.Unauthenticated, "invalid or missing token")
+		}
+		clientRole = roleAdmin
+	}
+	method := path.Base(fullMethod)
+	if clientRole == roleReadOnly && !readOnlyMethods[method] {
+		return status.Errorf(codes.PermissionDenied, "the read-only token can't call %s", method)
+	}
+	return nil
+}
+
+func (api *Handler) authorize(remote bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := api.checkToken(ctx, info.FullMethod, remote); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (api *Handler) authorizeStream(remote bool) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := api.checkToken(stream.Context(), info.FullMethod, remote); err != nil {
+			return err
+		}
+		return handler(srv, stream)
+	}
+}
@@ -0,0 +1,227 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	pb "github.com/dunstorm/pm2-go/proto"
+	"github.com/dunstorm/pm2-go/shared"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// a process before an item of a batch changed it, nil when the item created it
+type batchUndo struct {
+	name     string
+	previous *pb.Process
+}
+
+// apply the items of a batch in order. with atomic, the items applied before a
+// failure are rolled back in reverse order and the next ones are skipped
+func (api *Handler) BatchApply(ctx context.Context, in *pb.BatchApplyRequest) (*pb.BatchApplyResponse, error) {
+	for i, item := range in.Items {
+		if err := validateBatchItem(item); err != nil {
+			return nil, status.Errorf(400, "item %d: %v", i, err)
+		}
+	}
+
+	// batches don't interleave with each other
+	api.batchMu.Lock()
+	defer api.batchMu.Unlock()
+
+	response := &pb.BatchApplyResponse{}
+	var undos []*batchUndo
+	failed := false
+	for _, item := range in.Items {
+		result := &pb.BatchItemResult{Action: item.Action, Name: item.Target}
+		if item.Action == shared.BatchStart {
+			result.Name = item.Spec.Name
+		}
+		if failed && in.Atomic {
+			result.Error = "skipped after a failure"
+			response.Results = append(response.Results, result)
+			continue
+		}
+
+		undo, process, err := api.applyBatchItem(ctx, item)
+		if undo != nil {
+			undos = append(undos, undo)
+			result.Name = undo.name
+		}
+		if err != nil {
+			api.logger.Error().Msgf("failed to %s %s: %s", item.Action, result.Name, err)
+			result.Error = status.Convert(err).Message()
+			failed = true
+		} else {
+			result.Success = true
+			result.Process = process
+			if process != nil {
+				result.Name = process.Name
+			}
+		}
+		response.Results = append(response.Results, result)
+	}
+
+	if failed && in.Atomic {
+		// the client may be gone, the rollback is done anyway
+		ctx = context.WithoutCancel(ctx)
+		for i := len(undos) - 1; i >= 0; i-- {
+			if err := api.undoBatchItem(ctx, undos[i]); err != nil {
+				api.logger.Error().Msgf("failed to roll back %s: %s", undos[i].name, err)
+			}
+		}
+		response.RolledBack = true
+	}
+	return response, nil
+}
+
+func validateBatchItem(item *pb.BatchItem) error {
+	switch item.Action {
+	case shared.BatchStart:
+		if item.Spec == nil || item.Spec.Name == "" || item.Spec.ExecutablePath == "" {
+			return fmt.Errorf("start requires a spec with a name and an executable path")
+		}
+	case shared.BatchStop, shared.BatchRestart, shared.BatchDelete:
+		if item.Target == "" {
+			return fmt.Errorf("%s requires a target", item.Action)
+		}
+	default:
+		return fmt.Errorf("unknown action %q", item.Action)
+	}
+	return nil
+}
+
+// the process with a name or id, nil if there is none. api.mu is held
+func (api *Handler) lookup(target string) *pb.Process {
+	process := api.databaseByName[target]
+	if id, err := strconv.Atoi(target); process == nil && err == nil {
+		process = api.databaseById[int32(id)]
+	}
+	return process
+}
+
+// a copy of the process with a name or id, nil if there is none
+func (api *Handler) snapshot(target string) *pb.Process {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	process := api.lookup(target)
+	if process == nil {
+		return nil
+	}
+	return proto.Clone(process).(*pb.Process)
+}
+
+// stop a process looked up with api.mu held if it's online, the lock is
+// released either way
+func (api *Handler) stopIfOnline(process *pb.Process) error {
+	if process == nil || process.ProcStatus.Status != "online" {
+		api.mu.Unlock()
+		return nil
+	}
+	_, err := api.stopProcess(process)
+	return err
+}
+
+// apply an item of a batch, the undo is nil until something changed. the
+// process is looked up and stopped under the same lock
+func (api *Handler) applyBatchItem(ctx context.Context, item *pb.BatchItem) (*batchUndo, *pb.Process, error) {
+	target := item.Target
+	if item.Action == shared.BatchStart {
+		target = item.Spec.Name
+	}
+	api.mu.Lock()
+	current := api.lookup(target)
+	if current == nil && item.Action != shared.BatchStart {
+		api.mu.Unlock()
+		return nil, nil, status.Errorf(codes.NotFound, "process %s not found", target)
+	}
+
+	undo := &batchUndo{name: target}
+	if current != nil {
+		undo.name = current.Name
+		undo.previous = proto.Clone(current).(*pb.Process)
+	}
+	online := current != nil && current.ProcStatus.Status == "online"
+
+	if item.Action == shared.BatchStop && !online {
+		api.mu.Unlock()
+		return nil, undo.previous, nil
+	}
+	if err := api.stopIfOnline(current); err != nil {
+		return undo, nil, err
+	}
+
+	switch item.Action {
+	case shared.BatchStop:
+		return undo, api.snapshot(undo.name), nil
+	case shared.BatchDelete:
+		_, err := api.DeleteProcess(ctx, &pb.DeleteProcessRequest{Id: current.Id})
+		return undo, nil, err
+	}
+
+	// start and restart spawn the process again
+	spec := item.Spec
+	if item.Action == shared.BatchRestart {
+		spec = undo.previous.SpawnRequest()
+	}
+	if _, err := api.SpawnProcess(ctx, spec); err != nil {
+		if current == nil {
+			return nil, nil, err
+		}
+		return undo, nil, err
+	}
+	return undo, api.snapshot(undo.name), nil
+}
+
+// put a process back in its state before an item of a batch. its entry is
+// restored in place, with the same id and counters, and it's only spawned
+// again if it was online
+func (api *Handler) undoBatchItem(ctx context.Context, undo *batchUndo) error {
+	api.mu.Lock()
+	if undo.previous == nil {
+		current := api.databaseByName[undo.name]
+		if current == nil {
+			api.mu.Unlock()
+			return nil
+		}
+		if err := api.stopIfOnline(current); err != nil {
+			return err
+		}
+		_, err := api.DeleteProcess(ctx, &pb.DeleteProcessRequest{Id: current.Id})
+		return err
+	}
+
+	previous := undo.previous
+	if err := api.stopIfOnline(api.databaseById[previous.Id]); err != nil {
+		return err
+	}
+
+	api.mu.Lock()
+	if other := api.databaseByName[previous.Name]; other != nil && other.Id != previous.Id {
+		api.mu.Unlock()
+		return status.Errorf(400, "the name %s was taken by process %d", previous.Name, other.Id)
+	}
+	if current := api.databaseById[previous.Id]; current != nil {
+		delete(api.databaseByName, current.Name)
+	}
+	restored := proto.Clone(previous).(*pb.Process)
+	if restored.ProcStatus.Status == "online" {
+		// it's spawned again below, not by the scheduler
+		restored.SetStopSignal(true)
+		restored.SetStatus("stopped")
+	}
+	restored.ResetPid()
+	restored.ResetCPUMemory()
+	api.databaseById[restored.Id] = restored
+	api.databaseByName[restored.Name] = restored
+	delete(api.processes, restored.Id)
+	api.mu.Unlock()
+
+	if previous.ProcStatus.Status != "online" {
+		return nil
+	}
+	_, err := api.SpawnProcess(ctx, previous.SpawnRequest())
+	return err
+}
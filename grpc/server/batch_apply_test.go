@@ -0,0 +1,145 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/dunstorm/pm2-go/proto"
+	"github.com/dunstorm/pm2-go/shared"
+)
+
+func sleepSpec(name string) *pb.SpawnProcessRequest {
+	return &pb.SpawnProcessRequest{Name: name, ExecutablePath: "sleep", Args: []string{"30"}}
+}
+
+// a handler whose processes are stopped at the end of the test
+func newBatchTestHandler(t *testing.T) *Handler {
+	api := newTestHandler(t)
+	t.Cleanup(func() {
+		api.mu.Lock()
+		ids := make([]int32, 0, len(api.processes))
+		for id, p := range api.processes {
+			if p != nil {
+				ids = append(ids, id)
+			}
+		}
+		api.mu.Unlock()
+		for _, id := range ids {
+			api.StopProcess(context.Background(), &pb.StopProcessRequest{Id: id})
+		}
+	})
+	return api
+}
+
+func TestBatchApply(t *testing.T) {
+	api := newBatchTestHandler(t)
+	ctx := context.Background()
+
+	response, err := api.BatchApply(ctx, &pb.BatchApplyRequest{Items: []*pb.BatchItem{
+		{Action: shared.BatchStart, Spec: sleepSpec("first")},
+		{Action: shared.BatchStart, Spec: sleepSpec("second")},
+		{Action: shared.BatchStop, Target: "first"},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.RolledBack {
+		t.Error("a successful batch was rolled back")
+	}
+	for _, result := range response.Results {
+		if !result.Success {
+			t.Errorf("%s of %s failed: %s", result.Action, result.Name, result.Error)
+		}
+	}
+
+	expected := map[string]string{"first": "stopped", "second": "online"}
+	for name, status := range expected {
+		process := api.snapshot(name)
+		if process == nil {
+			t.Fatalf("process %s not found", name)
+		}
+		if process.ProcStatus.Status != status {
+			t.Errorf("expected %s to be %s, got %s", name, status, process.ProcStatus.Status)
+		}
+	}
+}
+
+func TestBatchApplyAtomicRollback(t *testing.T) {
+	api := newBatchTestHandler(t)
+	ctx := context.Background()
+
+	for _, name := range []string{"online", "stopped"} {
+		if _, err := api.SpawnProcess(ctx, sleepSpec(name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	stopped := api.snapshot("stopped")
+	if _, err := api.StopProcess(ctx, &pb.StopProcessRequest{Id: stopped.Id}); err != nil {
+		t.Fatal(err)
+	}
+	api.mu.Lock()
+	api.databaseByName["online"].ProcStatus.Restarts = 3
+	nextId := api.nextId
+	api.mu.Unlock()
+	before := map[string]*pb.Process{"online": api.snapshot("online"), "stopped": api.snapshot("stopped")}
+
+	response, err := api.BatchApply(ctx, &pb.BatchApplyRequest{Atomic: true, Items: []*pb.BatchItem{
+		{Action: shared.BatchRestart, Target: "online"},
+		{Action: shared.BatchDelete, Target: "stopped"},
+		{Action: shared.BatchStart, Spec: &pb.SpawnProcessRequest{Name: "broken", ExecutablePath: "/nonexistent/broken"}},
+		{Action: shared.BatchStop, Target: "online"},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !response.RolledBack {
+		t.Fatal("the batch wasn't rolled back")
+	}
+	if response.Results[2].Success || response.Results[3].Error != "skipped after a failure" {
+		t.Errorf("expected the start to fail and the stop to be skipped, got %v", response.Results)
+	}
+
+	for name, previous := range before {
+		process := api.snapshot(name)
+		if process == nil {
+			t.Fatalf("process %s wasn't restored", name)
+		}
+		if process.Id != previous.Id {
+			t.Errorf("expected %s to keep id %d, got %d", name, previous.Id, process.Id)
+		}
+		if process.ProcStatus.Restarts != previous.ProcStatus.Restarts {
+			t.Errorf("expected %s to keep %d restarts, got %d", name, previous.ProcStatus.Restarts, process.ProcStatus.Restarts)
+		}
+		if process.ProcStatus.Status != previous.ProcStatus.Status {
+			t.Errorf("expected %s to be %s, got %s", name, previous.ProcStatus.Status, process.ProcStatus.Status)
+		}
+	}
+	if api.snapshot("broken") != nil {
+		t.Error("the failed app wasn't removed")
+	}
+	if api.nextId != nextId {
+		t.Errorf("expected the next id to stay %d, got %d", nextId, api.nextId)
+	}
+}
+
+func TestBatchApplyRejectsInvalidItems(t *testing.T) {
+	api := newBatchTestHandler(t)
+	ctx := context.Background()
+
+	for _, item := range []*pb.BatchItem{
+		{Action: "reload", Target: "app"},
+		{Action: shared.BatchStart},
+		{Action: shared.BatchStop},
+	} {
+		_, err := api.BatchApply(ctx, &pb.BatchApplyRequest{Items: []*pb.BatchItem{
+			{Action: shared.BatchStart, Spec: sleepSpec("valid")},
+			item,
+		}})
+		if err == nil {
+			t.Errorf("expected %s of %q to be rejected", item.Action, item.Target)
+		}
+	}
+	if api.snapshot("valid") != nil {
+		t.Error("a batch with an invalid item was applied")
+	}
+}
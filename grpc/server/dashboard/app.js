@@ -0,0 +1,241 @@
+"use strict";
+
+// the api token is given once in the url or prompted, then kept by the browser
+const tokenKey = "pm2-go-token";
+const refreshInterval = 2000;
+const maxLogLines = 1000;
+
+let token = new URLSearchParams(location.search).get("token");
+if (token) {
+  localStorage.setItem(tokenKey, token);
+  history.replaceState(null, "", location.pathname);
+} else {
+  token = localStorage.getItem(tokenKey);
+}
+
+let selected = null;
+let logs = null;
+
+const $ = (selector) => document.querySelector(selector);
+
+function askToken(message) {
+  const given = prompt(message || "API token, see ~/.pm2-go/token");
+  if (given) {
+    token = given.trim();
+    localStorage.setItem(tokenKey, token);
+  }
+}
+
+async function api(method, path) {
+  const response = await fetch(path, {
+    method,
+    headers: { Authorization: "Bearer " + token },
+  });
+  if (response.status === 401) {
+    askToken("Invalid token, API token from ~/.pm2-go/token");
+    throw new Error("unauthorized");
+  }
+  const body = await response.json();
+  if (!response.ok) {
+    throw new Error(body.error || response.statusText);
+  }
+  return body;
+}
+
+function setStatus(message, isError) {
+  const status = $("#status");
+  status.textContent = message;
+  status.className = isError ? "stopped" : "";
+}
+
+function formatDuration(seconds) {
+  seconds = Math.max(0, Math.floor(seconds));
+  if (seconds < 60) return seconds + "s";
+  if (seconds < 3600) return Math.floor(seconds / 60) + "m";
+  if (seconds < 86400) return Math.floor(seconds / 3600) + "h";
+  return Math.floor(seconds / 86400) + "d";
+}
+
+function formatBytes(bytes) {
+  const units = ["B", "KB", "MB", "GB", "TB"];
+  let i = 0;
+  while (bytes >= 1024 && i < units.length - 1) {
+    bytes /= 1024;
+    i++;
+  }
+  return bytes.toFixed(i === 0 ? 0 : 1) + units[i];
+}
+
+function cell(row, text, className) {
+  const td = row.insertCell();
+  td.textContent = text;
+  if (className) td.className = className;
+  return td;
+}
+
+function renderProcesses(processes) {
+  const tbody = $("#processes tbody");
+  tbody.replaceChildren();
+  for (const p of processes) {
+    const status = p.proc_status || {};
+    const online = status.status === "online";
+    const row = tbody.insertRow();
+    row.classList.toggle("selected", p.id === selected?.id);
+    row.onclick = () => select(p);
+
+    cell(row, p.id);
+    cell(row, p.name);
+    cell(row, online ? p.pid : "");
+    cell(row, status.status, online ? "online" : "stopped");
+    cell(row, status.restarts);
+    cell(row, online && status.started_at ? formatDuration((Date.now() - Date.parse(status.started_at)) / 1000) : "0s");
+    cell(row, status.cpu);
+    cell(row, status.memory);
+
+    const actions = cell(row, "", "actions");
+    for (const action of ["start", "stop", "restart"]) {
+      const button = document.createElement("button");
+      button.textContent = action;
+      button.disabled = (action === "start" && online) || (action === "stop" && !online);
+      button.onclick = (event) => {
+        event.stopPropagation();
+        run(p, action, button);
+      };
+      actions.appendChild(button);
+    }
+  }
+}
+
+async function run(p, action, button) {
+  button.disabled = true;
+  try {
+    await api("POST", `/api/processes/${p.id}/${action}`);
+  } catch (err) {
+    alert(`Failed to ${action} ${p.name}: ${err.message}`);
+  }
+  if (selected?.id === p.id) {
+    // logs are followed from the new log files
+    followLogs(p);
+  }
+  refresh();
+}
+
+async function refresh() {
+  if (!token) return;
+  try {
+    const response = await api("GET", "/api/processes");
+    const processes = response.processes || [];
+    renderProcesses(processes);
+    if (selected) {
+      const current = processes.find((p) => p.id === selected.id);
+      if (!current) {
+        closeDetail();
+      } else {
+        selected = current;
+        await refreshHistory();
+      }
+    }
+    setStatus(`${processes.length} processes, updated ${new Date().toLocaleTimeString()}`);
+  } catch (err) {
+    setStatus(err.message, true);
+  }
+}
+
+function select(p) {
+  if (selected?.id === p.id) return;
+  selected = p;
+  $("#detail").hidden = false;
+  $("#detail-name").textContent = `${p.id} · ${p.name}`;
+  $("#events tbody").replaceChildren();
+  for (const chart of ["#cpu-chart", "#memory-chart"]) {
+    $(chart).replaceChildren();
+  }
+  followLogs(p);
+  refresh();
+}
+
+function closeDetail() {
+  selected = null;
+  $("#detail").hidden = true;
+  if (logs) {
+    logs.close();
+    logs = null;
+  }
+}
+
+// draw a line chart of the values, scaled from 0 to their max
+function renderChart(svg, values, minMax) {
+  svg.replaceChildren();
+  const ns = "http://www.w3.org/2000/svg";
+  const [width, height] = [600, 150];
+  for (const y of [0.25, 0.5, 0.75]) {
+    const line = document.createElementNS(ns, "line");
+    line.setAttribute("x1", 0);
+    line.setAttribute("x2", width);
+    line.setAttribute("y1", height * y);
+    line.setAttribute("y2", height * y);
+    svg.appendChild(line);
+  }
+  if (values.length === 0) return;
+  const max = Math.max(minMax, ...values) * 1.1;
+  const step = values.length > 1 ? width / (values.length - 1) : 0;
+  const points = values.map((value, i) => `${i * step},${height - (value / max) * height}`);
+  const polyline = document.createElementNS(ns, "polyline");
+  polyline.setAttribute("points", points.join(" "));
+  svg.appendChild(polyline);
+}
+
+async function refreshHistory() {
+  const id = selected.id;
+  const history = await api("GET", `/api/processes/${id}/history`);
+  if (selected?.id !== id) return;
+
+  const samples = history.samples || [];
+  const cpu = samples.map((s) => s.cpu);
+  const memory = samples.map((s) => Number(s.memory));
+  renderChart($("#cpu-chart"), cpu, 1);
+  renderChart($("#memory-chart"), memory, 1024 * 1024);
+  const last = samples[samples.length - 1];
+  $("#cpu-current").textContent = last ? `${last.cpu.toFixed(1)}% (max ${Math.max(...cpu).toFixed(1)}%)` : "no samples yet";
+  $("#memory-current").textContent = last ? `${formatBytes(Number(last.memory))} (max ${formatBytes(Math.max(...memory))})` : "no samples yet";
+
+  const tbody = $("#events tbody");
+  tbody.replaceChildren();
+  for (const event of (history.events || []).slice().reverse()) {
+    const row = tbody.insertRow();
+    cell(row, new Date(event.time).toLocaleString());
+    cell(row, event.type);
+    cell(row, event.detail);
+  }
+}
+
+function followLogs(p) {
+  if (logs) logs.close();
+  const pre = $("#logs");
+  pre.replaceChildren();
+  logs = new EventSource(`/api/processes/${p.id}/logs?lines=50&token=${encodeURIComponent(token)}`);
+  for (const stream of ["out", "err"]) {
+    logs.addEventListener(stream, (event) => {
+      const atBottom = pre.scrollTop + pre.clientHeight >= pre.scrollHeight - 4;
+      const line = document.createElement("div");
+      line.className = stream;
+      line.textContent = event.data;
+      pre.appendChild(line);
+      while (pre.childElementCount > maxLogLines) {
+        pre.firstElementChild.remove();
+      }
+      if (atBottom) pre.scrollTop = pre.scrollHeight;
+    });
+  }
+}
+
+$("#logout").onclick = () => {
+  localStorage.removeItem(tokenKey);
+  token = null;
+  askToken();
+  refresh();
+};
+
+if (!token) askToken();
+refresh();
+setInterval(refresh, refreshInterval);
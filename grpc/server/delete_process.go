@@ -4,6 +4,9 @@ import (
 	"context"
 
 	pb "github.com/dunstorm/pm2-go/proto"
+	"github.com/dunstorm/pm2-go/shared"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // delete process
@@ -12,10 +15,20 @@ func (api *Handler) DeleteProcess(ctx context.Context, in *pb.DeleteProcessReque
 	defer api.mu.Unlock()
 
 	process := api.databaseById[in.Id]
+	if process == nil {
+		return nil, status.Errorf(codes.NotFound, "process %d not found", in.Id)
+	}
 
 	delete(api.databaseById, process.Id)
 	delete(api.databaseByName, process.Name)
 	delete(api.processes, in.Id)
+	delete(api.histories, in.Id)
+	delete(api.logRotations, in.Id)
+	delete(api.healthChecks, in.Id)
+
+	if err := shared.RemoveCgroup(process.Cgroup); err != nil {
+		api.logger.Warn().Msgf("failed to remove the cgroup of %s: %s", process.Name, err)
+	}
 
 	return &pb.DeleteProcessResponse{
 		Success: true,
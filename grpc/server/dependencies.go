@@ -0,0 +1,60 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/dunstorm/pm2-go/proto"
+)
+
+// time given to the dependencies of a process to be ready before it's spawned
+const dependencyTimeout = time.Minute
+
+// check if a dependency can be relied on, it's online and healthy if it has a health check
+func dependencyReady(p *pb.Process) bool {
+	if p.ProcStatus.Status != "online" {
+		return false
+	}
+	return p.HealthCheck == nil || p.ProcStatus.Health == "healthy"
+}
+
+// wait for the dependencies of a process to be ready
+func (api *Handler) waitForDependencies(ctx context.Context, name string, dependsOn []string) error {
+	if len(dependsOn) == 0 {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, dependencyTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		pending := ""
+		api.mu.Lock()
+		for _, dependency := range dependsOn {
+			p := api.databaseByName[dependency]
+			if p == nil {
+				api.mu.Unlock()
+				return fmt.Errorf("%s depends on unknown process %s", name, dependency)
+			}
+			if !dependencyReady(p) {
+				pending = fmt.Sprintf("%s (%s)", dependency, p.ProcStatus.Status)
+				if p.ProcStatus.Status == "online" {
+					pending = fmt.Sprintf("%s (%s)", dependency, p.ProcStatus.Health)
+				}
+				break
+			}
+		}
+		api.mu.Unlock()
+		if pending == "" {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("dependency %s of %s is not ready", pending, name)
+		case <-ticker.C:
+		}
+	}
+}
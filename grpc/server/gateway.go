@@ -0,0 +1,308 @@
+package server
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	pb "github.com/dunstorm/pm2-go/proto"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+//go:embed openapi.json
+var openAPI []byte
+
+var (
+	marshalOptions   = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+	unmarshalOptions = protojson.UnmarshalOptions{DiscardUnknown: true}
+)
+
+var errProcessNotFound = errors.New("process not found")
+
+// the http api exposes the ProcessManager operations as json endpoints, they go
+// through the methods of the grpc handler
+func (api *Handler) apiHandler() (http.Handler, error) {
+	if api.tokens.admin == "" {
+		return nil, fmt.Errorf("the api tokens couldn't be loaded")
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /", dashboardHandler())
+	mux.HandleFunc("GET /api/openapi.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(openAPI)
+	})
+	routes := map[string]http.HandlerFunc{
+		"GET /api/processes":                   api.apiListProcesses,
+		"POST /api/processes":                  api.apiSpawnProcess,
+		"GET /api/processes/{target}":          api.apiDescribeProcess,
+		"DELETE /api/processes/{target}":       api.apiDeleteProcess,
+		"POST /api/processes/{target}/start":   api.apiStartProcess,
+		"POST /api/processes/{target}/stop":    api.apiStopProcess,
+		"POST /api/processes/{target}/restart": api.apiRestartProcess,
+		"GET /api/processes/{target}/logs":     api.apiProcessLogs,
+		"GET /api/processes/{target}/history":  api.apiProcessHistory,
+	}
+	for pattern, handler := range routes {
+		// the read-only token can only get
+		required := roleAdmin
+		if strings.HasPrefix(pattern, "GET ") {
+			required = roleReadOnly
+		}
+		mux.Handle(pattern, authorized(api.tokens, required, handler))
+	}
+	return mux, nil
+}
+
+// require a token with the given role as a bearer token, or as the token query
+// parameter for clients which can't set headers such as EventSource
+func authorized(t tokens, required role, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		given, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok {
+			given = r.URL.Query().Get("token")
+		}
+		clientRole := t.role(given)
+		if clientRole == roleNone {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid or missing token"})
+			return
+		}
+		if clientRole < required {
+			writeJSON(w, http.StatusForbidden, map[string]string{"error": "the read-only token can't change processes"})
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func writeJSON(w http.ResponseWriter, code int, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(value)
+}
+
+func writeProto(w http.ResponseWriter, code int, message proto.Message) {
+	content, err := marshalOptions.Marshal(message)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write(content)
+}
+
+// write an error of a handler, with the message of grpc errors
+func writeError(w http.ResponseWriter, err error) {
+	code := http.StatusInternalServerError
+	message := err.Error()
+	if errors.Is(err, errProcessNotFound) {
+		code = http.StatusNotFound
+	} else if s, ok := status.FromError(err); ok {
+		code = http.StatusBadRequest
+		message = s.Message()
+	}
+	writeJSON(w, code, map[string]string{"error": message})
+}
+
+// find a process by name or id, the copy can be used outside of the lock
+func (api *Handler) findProcess(ctx context.Context, target string) (*pb.Process, error) {
+	process, err := api.FindProcess(ctx, &pb.FindProcessRequest{Name: target})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errProcessNotFound, target)
+	}
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	return proto.Clone(process).(*pb.Process), nil
+}
+
+// stop a process if it's online, then spawn it again
+func (api *Handler) restart(ctx context.Context, process *pb.Process) (*pb.Process, error) {
+	if process.ProcStatus.Status == "online" {
+		if _, err := api.StopProcess(ctx, &pb.StopProcessRequest{Id: process.Id}); err != nil {
+			return nil, err
+		}
+	}
+	response, err := api.SpawnProcess(ctx, process.SpawnRequest())
+	if err != nil {
+		return nil, err
+	}
+	return response.Process, nil
+}
+
+// stop a process if it's online, then delete it
+func (api *Handler) remove(ctx context.Context, process *pb.Process) error {
+	if process.ProcStatus.Status == "online" {
+		if _, err := api.StopProcess(ctx, &pb.StopProcessRequest{Id: process.Id}); err != nil {
+			return err
+		}
+	}
+	_, err := api.DeleteProcess(ctx, &pb.DeleteProcessRequest{Id: process.Id})
+	return err
+}
+
+// reply with the state of a process after an action
+func (api *Handler) writeProcess(w http.ResponseWriter, r *http.Request, code int, id int32) {
+	process, err := api.findProcess(r.Context(), strconv.Itoa(int(id)))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeProto(w, code, process)
+}
+
+func (api *Handler) apiListProcesses(w http.ResponseWriter, r *http.Request) {
+	response, err := api.ListProcess(r.Context(), &pb.ListProcessRequest{})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	api.mu.Lock()
+	response = proto.Clone(response).(*pb.ListProcessResponse)
+	api.mu.Unlock()
+	writeProto(w, http.StatusOK, response)
+}
+
+func (api *Handler) apiDescribeProcess(w http.ResponseWriter, r *http.Request) {
+	process, err := api.findProcess(r.Context(), r.PathValue("target"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	process.UpdateCPUMemory()
+	writeProto(w, http.StatusOK, process)
+}
+
+// spawn a new process, paths are resolved by the daemon
+func (api *Handler) apiSpawnProcess(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, 1<<20))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	request := &pb.SpawnProcessRequest{}
+	if err := unmarshalOptions.Unmarshal(body, request); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": fmt.Sprintf("invalid request: %v", err)})
+		return
+	}
+	if request.ExecutablePath == "" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "executable_path is required"})
+		return
+	}
+	if request.Name == "" {
+		request.Name = strings.ToLower(request.ExecutablePath)
+	}
+	response, err := api.SpawnProcess(r.Context(), request)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	api.writeProcess(w, r, http.StatusCreated, response.Process.Id)
+}
+
+func (api *Handler) apiStartProcess(w http.ResponseWriter, r *http.Request) {
+	process, err := api.findProcess(r.Context(), r.PathValue("target"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if _, err := api.SpawnProcess(r.Context(), process.SpawnRequest()); err != nil {
+		writeError(w, err)
+		return
+	}
+	api.writeProcess(w, r, http.StatusOK, process.Id)
+}
+
+func (api *Handler) apiStopProcess(w http.ResponseWriter, r *http.Request) {
+	process, err := api.findProcess(r.Context(), r.PathValue("target"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if _, err := api.StopProcess(r.Context(), &pb.StopProcessRequest{Id: process.Id}); err != nil {
+		writeError(w, err)
+		return
+	}
+	api.writeProcess(w, r, http.StatusOK, process.Id)
+}
+
+func (api *Handler) apiRestartProcess(w http.ResponseWriter, r *http.Request) {
+	process, err := api.findProcess(r.Context(), r.PathValue("target"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if _, err := api.restart(r.Context(), process); err != nil {
+		writeError(w, err)
+		return
+	}
+	api.writeProcess(w, r, http.StatusOK, process.Id)
+}
+
+func (api *Handler) apiDeleteProcess(w http.ResponseWriter, r *http.Request) {
+	process, err := api.findProcess(r.Context(), r.PathValue("target"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if err := api.remove(r.Context(), process); err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]bool{"success": true})
+}
+
+func (api *Handler) apiProcessHistory(w http.ResponseWriter, r *http.Request) {
+	process, err := api.findProcess(r.Context(), r.PathValue("target"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	history, err := api.GetProcessHistory(r.Context(), &pb.GetProcessHistoryRequest{Id: process.Id})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeProto(w, http.StatusOK, history)
+}
+
+// stream the logs of a process as server-sent events, the last lines of each stream
+// first, then the new lines unless follow is false
+func (api *Handler) apiProcessLogs(w http.ResponseWriter, r *http.Request) {
+	process, err := api.findProcess(r.Context(), r.PathValue("target"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	lines := 15
+	if value := r.URL.Query().Get("lines"); value != "" {
+		if lines, err = strconv.Atoi(value); err != nil || lines < 0 {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": fmt.Sprintf("invalid lines %q", value)})
+			return
+		}
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "streaming isn't supported"})
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	follow := r.URL.Query().Get("follow") != "false"
+	api.followLogs(r.Context(), process, lines, follow, func(stream string, line string) {
+		fmt.Fprintf(w, "event: %s\ndata: %s\n\n", stream, line)
+		flusher.Flush()
+	})
+}
@@ -0,0 +1,126 @@
+package server
+
+import (
+	"sync/atomic"
+	"time"
+
+	pb "github.com/dunstorm/pm2-go/proto"
+	"github.com/dunstorm/pm2-go/shared"
+	"google.golang.org/protobuf/proto"
+)
+
+// health check state of a process, kept by the scheduler
+type healthCheckState struct {
+	next    time.Time
+	running atomic.Bool
+}
+
+// start the health check of an online process if it's due, without waiting for it
+func scheduleHealthCheck(handler *Handler, p *pb.Process, state *healthCheckState, now time.Time) {
+	if p.HealthCheck == nil || p.ProcStatus.Status != "online" {
+		return
+	}
+	if state.running.Load() || now.Before(state.next) {
+		return
+	}
+
+	check := shared.HealthCheckWithDefaults(p.HealthCheck)
+	state.next = now.Add(check.Interval.AsDuration())
+	state.running.Store(true)
+
+	pid, cwd := p.Pid, p.Cwd
+	userName, groupName := p.User, p.Group
+	startedAt := p.ProcStatus.StartedAt.AsTime()
+	go func() {
+		defer state.running.Store(false)
+		credential, err := shared.LookupCredential(userName, groupName)
+		if err == nil {
+			err = shared.RunHealthCheck(check, cwd, credential)
+		}
+		if updateHealth(handler, p, check, pid, startedAt, err) {
+			restartUnhealthy(handler, p, pid)
+		}
+	}()
+}
+
+// record the result of a health check, true if the process is to be restarted as it's unhealthy
+func updateHealth(handler *Handler, p *pb.Process, check *pb.HealthCheck, pid int32, startedAt time.Time, err error) bool {
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+
+	// the process was stopped or restarted during the check
+	if p.Pid != pid || p.ProcStatus.Status != "online" {
+		return false
+	}
+
+	if err == nil {
+		if p.ProcStatus.Health != "healthy" {
+			handler.logger.Info().Msgf("Process %s is healthy", p.Name)
+		}
+		p.ProcStatus.Health = "healthy"
+		p.ProcStatus.HealthFailures = 0
+		return false
+	}
+
+	if time.Since(startedAt) < check.GracePeriod.AsDuration() {
+		handler.logger.Debug().Msgf("Health check of process %s failed during grace period: %s", p.Name, err)
+		return false
+	}
+
+	p.ProcStatus.HealthFailures++
+	handler.logger.Warn().Msgf("Health check of process %s failed (%d/%d): %s", p.Name, p.ProcStatus.HealthFailures, check.Retries, err)
+	if p.ProcStatus.HealthFailures < check.Retries {
+		return false
+	}
+
+	if p.ProcStatus.Health != "unhealthy" {
+		handler.logger.Error().Msgf("Process %s is unhealthy", p.Name)
+	}
+	p.ProcStatus.Health = "unhealthy"
+
+	return check.Restart
+}
+
+// stop an unhealthy process and start it again
+func restartUnhealthy(handler *Handler, p *pb.Process, pid int32) {
+	// the process may be deleted, stopped or restarted meanwhile
+	changed := func() bool {
+		return handler.databaseById[p.Id] != p || p.Pid != pid || p.ProcStatus.Status != "online"
+	}
+
+	handler.mu.Lock()
+	if changed() {
+		handler.mu.Unlock()
+		return
+	}
+	hookProcess := proto.Clone(p).(*pb.Process)
+	handler.mu.Unlock()
+	runPreStopHook(handler, hookProcess)
+
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+	if changed() {
+		return
+	}
+
+	handler.logger.Info().Msgf("Stopping unhealthy process %s", p.Name)
+	found := handler.processes[p.Id]
+	p.UpdateUptime()
+	p.ResetPid()
+	p.UpdateStatus("stopped")
+	p.ResetCPUMemory()
+	updateProcessMap(handler, p.Id, nil)
+	if found != nil {
+		stopSignal := p.StopSignal
+		handler.terminateUnlocked(p, pid)
+		handler.mu.Unlock()
+		runPostStopHook(handler, hookProcess)
+		handler.mu.Lock()
+
+		// the process may have been deleted, started or stopped meanwhile
+		if handler.databaseById[p.Id] != p || handler.processes[p.Id] != nil || (p.StopSignal && !stopSignal) {
+			return
+		}
+	}
+	restartProcess(handler, p, "unhealthy")
+}
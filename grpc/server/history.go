@@ -0,0 +1,140 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/dunstorm/pm2-go/proto"
+	"github.com/dunstorm/pm2-go/utils"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// cpu and memory of the processes are sampled every historyInterval for an hour
+	historyInterval   = 5 * time.Second
+	maxHistorySamples = 720
+	maxHistoryEvents  = 100
+
+	eventStart   = "start"
+	eventStop    = "stop"
+	eventExit    = "exit"
+	eventRestart = "restart"
+)
+
+// usage history and events of a process
+type processHistory struct {
+	samples []*pb.UsageSample
+	events  []*pb.ProcessEvent
+
+	// cpu time of the previous sample, to compute the usage in between
+	pid     int32
+	cpuTime float64
+	at      time.Time
+}
+
+// get the history of a process, api.mu is held
+func (api *Handler) processHistory(id int32) *processHistory {
+	history := api.histories[id]
+	if history == nil {
+		history = &processHistory{}
+		api.histories[id] = history
+	}
+	return history
+}
+
+// record an event of a process, api.mu is held
+func (api *Handler) recordEvent(p *pb.Process, eventType string, detail string) {
+	history := api.processHistory(p.Id)
+	history.events = append(history.events, &pb.ProcessEvent{
+		Time:   timestamppb.Now(),
+		Type:   eventType,
+		Detail: detail,
+	})
+	if len(history.events) > maxHistoryEvents {
+		history.events = history.events[len(history.events)-maxHistoryEvents:]
+	}
+}
+
+// sample the cpu and memory usage of the online processes
+func sampleUsage(handler *Handler) {
+	type target struct {
+		id        int32
+		process   *pb.Process
+		startedAt time.Time
+	}
+	for {
+		time.Sleep(historyInterval)
+
+		handler.mu.Lock()
+		var targets []target
+		for _, p := range handler.databaseById {
+			if p.ProcStatus.Status == "online" && p.Pid != 0 {
+				targets = append(targets, target{p.Id, &pb.Process{Pid: p.Pid}, p.ProcStatus.StartedAt.AsTime()})
+			}
+		}
+		handler.mu.Unlock()
+
+		// read the usage outside of the lock, ps may be slow
+		type usage struct {
+			cpuTime, cpu float64
+			memory       int64
+			fromProc     bool
+		}
+		usages := make([]usage, len(targets))
+		now := time.Now()
+		for i, t := range targets {
+			if cpuTime, memory, ok := utils.ProcessUsage(t.process.Pid); ok {
+				usages[i] = usage{cpuTime: cpuTime, memory: memory, fromProc: true}
+			} else if cpu, memory, ok := t.process.CPUMemory(); ok {
+				usages[i] = usage{cpu: cpu, memory: memory}
+			}
+		}
+
+		handler.mu.Lock()
+		for i, t := range targets {
+			if _, ok := handler.databaseById[t.id]; !ok {
+				continue
+			}
+			history := handler.processHistory(t.id)
+			u := usages[i]
+			if u.fromProc {
+				// the first sample of a process is averaged since it started
+				since, cpuTime := t.startedAt, 0.0
+				if history.pid == t.process.Pid {
+					since, cpuTime = history.at, history.cpuTime
+				}
+				if elapsed := now.Sub(since).Seconds(); elapsed > 0 {
+					u.cpu = (u.cpuTime - cpuTime) / elapsed * 100
+				}
+				history.pid, history.cpuTime, history.at = t.process.Pid, u.cpuTime, now
+			}
+			history.samples = append(history.samples, &pb.UsageSample{
+				Time:   timestamppb.New(now),
+				Cpu:    u.cpu,
+				Memory: u.memory,
+			})
+			if len(history.samples) > maxHistorySamples {
+				history.samples = history.samples[len(history.samples)-maxHistorySamples:]
+			}
+		}
+		handler.mu.Unlock()
+	}
+}
+
+// get the cpu and memory history and the events of a process
+func (api *Handler) GetProcessHistory(ctx context.Context, in *pb.GetProcessHistoryRequest) (*pb.GetProcessHistoryResponse, error) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	if api.databaseById[in.Id] == nil {
+		return nil, status.Errorf(400, "process %d not found", in.Id)
+	}
+	history := api.processHistory(in.Id)
+	response := &pb.GetProcessHistoryResponse{
+		Samples: history.samples,
+		Events:  history.events,
+	}
+	// the history keeps growing once the lock is released
+	return proto.Clone(response).(*pb.GetProcessHistoryResponse), nil
+}
@@ -0,0 +1,41 @@
+package server
+
+import (
+	pb "github.com/dunstorm/pm2-go/proto"
+	"github.com/dunstorm/pm2-go/shared"
+)
+
+// hooks may take long, they run outside of handler.mu so that the other requests go on
+
+// run a hook of a process as its user
+func runHook(handler *Handler, request *pb.SpawnProcessRequest, hook string) error {
+	credential, err := shared.LookupCredential(request.User, request.Group)
+	if err != nil {
+		return err
+	}
+	return shared.RunHook(request.Hooks, hook, request.Name, request.Cwd, credential, handler.logger)
+}
+
+// run the pre_start hook of a process about to be spawned
+func runPreStartHook(handler *Handler, request *pb.SpawnProcessRequest) error {
+	return runHook(handler, request, shared.HookPreStart)
+}
+
+// run the pre_stop hook of a copy of a process, a failing hook doesn't prevent it from stopping
+func runPreStopHook(handler *Handler, p *pb.Process) {
+	if err := runHook(handler, p.SpawnRequest(), shared.HookPreStop); err != nil {
+		handler.logger.Error().Msg(err.Error())
+	}
+}
+
+// run the post_stop hook of a copy of a process in the background
+func runPostStopHook(handler *Handler, p *pb.Process) {
+	if p.Hooks.GetPostStop() == "" {
+		return
+	}
+	go func() {
+		if err := runHook(handler, p.SpawnRequest(), shared.HookPostStop); err != nil {
+			handler.logger.Error().Msg(err.Error())
+		}
+	}()
+}
@@ -0,0 +1,77 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/dunstorm/pm2-go/proto"
+	"github.com/rs/zerolog"
+)
+
+// a handler spawning its processes with a temporary home
+func newTestHandler(t *testing.T) *Handler {
+	t.Setenv("HOME", t.TempDir())
+	logger := zerolog.Nop()
+	return newHandler(&logger)
+}
+
+// check ListProcess replies while a request is still running
+func assertListsWhileRunning(t *testing.T, api *Handler, running <-chan error) {
+	t.Helper()
+	listed := make(chan struct{})
+	go func() {
+		api.ListProcess(context.Background(), &pb.ListProcessRequest{})
+		close(listed)
+	}()
+	select {
+	case <-listed:
+	case <-time.After(time.Second):
+		t.Fatal("ListProcess is blocked by a running request")
+	}
+	select {
+	case <-running:
+		t.Fatal("the request was done before ListProcess was called")
+	default:
+	}
+}
+
+func TestSlowHooksDontBlockRequests(t *testing.T) {
+	api := newTestHandler(t)
+	ctx := context.Background()
+	request := &pb.SpawnProcessRequest{
+		Name:           "slow-hooks",
+		ExecutablePath: "sleep",
+		Args:           []string{"30"},
+		Hooks:          &pb.Hooks{PreStart: "sleep 2", PreStop: "sleep 2"},
+	}
+
+	spawned := make(chan error, 1)
+	go func() {
+		_, err := api.SpawnProcess(ctx, request)
+		spawned <- err
+	}()
+	time.Sleep(200 * time.Millisecond)
+	assertListsWhileRunning(t, api, spawned)
+	if err := <-spawned; err != nil {
+		t.Fatal(err)
+	}
+
+	process, err := api.FindProcess(ctx, &pb.FindProcessRequest{Name: request.Name})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stopped := make(chan error, 1)
+	go func() {
+		_, err := api.StopProcess(ctx, &pb.StopProcessRequest{Id: process.Id})
+		stopped <- err
+	}()
+	time.Sleep(200 * time.Millisecond)
+	assertListsWhileRunning(t, api, stopped)
+	if err := <-stopped; err != nil {
+		t.Fatal(err)
+	}
+	if status := process.ProcStatus.Status; status != "stopped" {
+		t.Errorf("expected the process to be stopped, got %s", status)
+	}
+}
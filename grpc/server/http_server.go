@@ -0,0 +1,49 @@
+package server
+
+import (
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// an optional http server of the daemon, which follows its configured address
+type httpServer struct {
+	name    string
+	address string
+	server  *http.Server
+}
+
+// start, stop or move the server to address, api.mu is held
+func (s *httpServer) apply(logger *zerolog.Logger, address string, handler func() (http.Handler, error)) {
+	if address == s.address {
+		return
+	}
+	if s.server != nil {
+		s.server.Close()
+		s.server = nil
+		logger.Info().Msgf("Stopped serving %s at %s", s.name, s.address)
+	}
+	s.address = ""
+	if address == "" {
+		return
+	}
+
+	h, err := handler()
+	if err != nil {
+		logger.Error().Msgf("Error while serving %s: %s", s.name, err)
+		return
+	}
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		logger.Error().Msgf("Error while serving %s: %s", s.name, err)
+		return
+	}
+	server := &http.Server{Handler: h, ReadHeaderTimeout: 5 * time.Second}
+	go server.Serve(lis)
+
+	s.server = server
+	s.address = address
+	logger.Info().Msgf("Serving %s at http://%s", s.name, lis.Addr())
+}
@@ -28,6 +28,9 @@ func (api *Handler) ListProcess(ctx context.Context, in *pb.ListProcessRequest)
 
 	var processes []*pb.Process
 	for _, k := range keys {
+		if in.Namespace != "" && api.databaseById[k].Namespace != in.Namespace {
+			continue
+		}
 		processes = append(processes, api.databaseById[k])
 	}
 
@@ -0,0 +1,77 @@
+package server
+
+import (
+	"context"
+	"os"
+	"sync"
+
+	pb "github.com/dunstorm/pm2-go/proto"
+	"github.com/dunstorm/pm2-go/utils"
+	"google.golang.org/grpc/status"
+)
+
+// send the last lines of the out and err streams of a process, then the new lines
+// until ctx is done if follow is set. send is never called concurrently
+func (api *Handler) followLogs(ctx context.Context, process *pb.Process, lines int, follow bool, send func(stream string, line string)) {
+	// stdout & stderr may share the same file, or be disabled
+	streams := map[string]string{}
+	if process.LogFilePath != os.DevNull {
+		streams["out"] = process.LogFilePath
+	}
+	if process.ErrFilePath != os.DevNull && process.ErrFilePath != process.LogFilePath {
+		streams["err"] = process.ErrFilePath
+	}
+
+	var mu sync.Mutex
+	locked := func(stream string, line string) {
+		mu.Lock()
+		defer mu.Unlock()
+		send(stream, line)
+	}
+	for _, stream := range []string{"out", "err"} {
+		if filePath, ok := streams[stream]; ok && lines > 0 {
+			logs, _ := utils.GetLogs(filePath, lines)
+			for _, line := range logs {
+				locked(stream, line)
+			}
+		}
+	}
+	if !follow {
+		return
+	}
+
+	var wg sync.WaitGroup
+	for stream, filePath := range streams {
+		wg.Add(1)
+		go func(stream string, filePath string) {
+			defer wg.Done()
+			if err := utils.FollowFile(ctx, filePath, func(line string) { locked(stream, line) }); err != nil {
+				api.logger.Error().Msgf("Error while following %s: %s", filePath, err)
+			}
+		}(stream, filePath)
+	}
+	wg.Wait()
+}
+
+// stream the logs of a process, for clients which can't read its log files
+func (api *Handler) StreamLogs(in *pb.StreamLogsRequest, stream pb.ProcessManager_StreamLogsServer) error {
+	api.mu.Lock()
+	process := api.databaseById[in.Id]
+	if process != nil {
+		process = &pb.Process{LogFilePath: process.LogFilePath, ErrFilePath: process.ErrFilePath}
+	}
+	api.mu.Unlock()
+	if process == nil {
+		return status.Errorf(400, "process %d not found", in.Id)
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	api.followLogs(ctx, process, int(in.Lines), in.Follow, func(name string, line string) {
+		// the client is gone
+		if err := stream.Send(&pb.LogLine{Stream: name, Line: line}); err != nil {
+			cancel()
+		}
+	})
+	return nil
+}
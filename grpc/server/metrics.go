@@ -0,0 +1,48 @@
+package server
+
+import (
+	"context"
+	"sort"
+
+	pb "github.com/dunstorm/pm2-go/proto"
+	"github.com/dunstorm/pm2-go/shared"
+	"google.golang.org/grpc/status"
+)
+
+// get the metrics sent by processes over their ipc channel
+func (api *Handler) GetMetrics(ctx context.Context, in *pb.GetMetricsRequest) (*pb.GetMetricsResponse, error) {
+	api.mu.Lock()
+	ids := in.Ids
+	if len(ids) == 0 {
+		for id := range api.databaseById {
+			ids = append(ids, id)
+		}
+		sort.Slice(ids, func(i, j int) bool {
+			return ids[i] < ids[j]
+		})
+	}
+	response := &pb.GetMetricsResponse{}
+	pids := make([]int32, len(ids))
+	for i, id := range ids {
+		process := api.databaseById[id]
+		if process == nil {
+			api.mu.Unlock()
+			return nil, status.Errorf(400, "process %d not found", id)
+		}
+		response.Processes = append(response.Processes, &pb.ProcessMetrics{Id: id, Name: process.Name})
+		if process.ProcStatus.Status == "online" {
+			pids[i] = process.Pid
+		}
+	}
+	api.mu.Unlock()
+
+	for i, pid := range pids {
+		if pid == 0 {
+			continue
+		}
+		if ipc := shared.IPCChannelOf(pid); ipc != nil {
+			response.Processes[i].Metrics = ipc.Metrics()
+		}
+	}
+	return response, nil
+}
@@ -1,13 +1,13 @@
 package server
 
 import (
-	"fmt"
 	"log"
 	"net"
 	"os"
 	"sync"
 
 	pb "github.com/dunstorm/pm2-go/proto"
+	"github.com/dunstorm/pm2-go/utils"
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc"
 )
@@ -20,26 +20,87 @@ type Handler struct {
 
 	processes map[int32]*os.Process
 	nextId    int32
+	// processes being terminated without the lock, they aren't spawned again meanwhile
+	terminating map[int32]bool
+	// serializes the batches
+	batchMu sync.Mutex
+
+	config utils.Config
+	tokens tokens
+
+	histories map[int32]*processHistory
+	// state of the scheduler, by process id
+	logRotations map[int32]logRotation
+	healthChecks map[int32]*healthCheckState
+
+	metrics       *daemonMetrics
+	metricsServer httpServer
+	apiServer     httpServer
+	remoteServer  remoteServer
 
 	pb.UnimplementedProcessManagerServer
 }
 
-func New(port int) {
+// start, stop or move the optional servers to their configured address, api.mu is held
+func (api *Handler) applyServers() {
+	api.metricsServer.apply(api.logger, api.config.MetricsAddress, api.metricsHandler)
+	api.apiServer.apply(api.logger, api.config.ApiAddress, api.apiHandler)
+	api.remoteServer.apply(api.logger, api.config, func(opts ...grpc.ServerOption) *grpc.Server {
+		return api.newGRPCServer(true, opts...)
+	})
+}
+
+// new grpc server serving the handler, remote clients always need a token
+func (api *Handler) newGRPCServer(remote bool, opts ...grpc.ServerOption) *grpc.Server {
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(api.observeRPC, api.authorize(remote)),
+		grpc.StreamInterceptor(api.authorizeStream(remote)),
+	)
+	s := grpc.NewServer(opts...)
+	pb.RegisterProcessManagerServer(s, api)
+	return s
+}
+
+// create a handler without processes, with the default config
+func newHandler(logger *zerolog.Logger) *Handler {
+	return &Handler{
+		logger:         logger,
+		databaseById:   make(map[int32]*pb.Process, 0),
+		databaseByName: make(map[string]*pb.Process, 0),
+		processes:      make(map[int32]*os.Process, 0),
+		histories:      make(map[int32]*processHistory),
+		logRotations:   make(map[int32]logRotation),
+		healthChecks:   make(map[int32]*healthCheckState),
+		terminating:    make(map[int32]bool),
+		metrics:        newDaemonMetrics(),
+		metricsServer:  httpServer{name: "metrics"},
+		apiServer:      httpServer{name: "http api"},
+		config:         utils.DefaultConfig(),
+	}
+}
+
+func New(address string) {
 	logger := zerolog.New(os.Stderr).With().Timestamp().Logger()
-	lis, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+	lis, err := net.Listen("tcp", address)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
-	s := grpc.NewServer()
-	handler := &Handler{
-		logger:         &logger,
-		databaseById:   make(map[int32]*pb.Process, 0),
-		databaseByName: make(map[string]*pb.Process, 0),
-		processes:      make(map[int32]*os.Process, 0),
+	handler := newHandler(&logger)
+	config, err := utils.LoadConfig()
+	if err != nil {
+		logger.Error().Msgf("Error while loading config, using defaults: %s", err)
+		config = utils.DefaultConfig()
+	}
+	handler.config = config
+	if handler.tokens, err = loadTokens(); err != nil {
+		logger.Error().Msgf("Error while loading tokens: %s", err)
 	}
-	pb.RegisterProcessManagerServer(s, handler)
+	handler.applyServers()
+	s := handler.newGRPCServer(false)
 
 	startScheduler(handler)
+	go sampleUsage(handler)
+	watchConfig(handler)
 
 	handler.logger.Info().Msgf("Serving GRPC server at %s", lis.Addr())
 
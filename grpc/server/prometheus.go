@@ -0,0 +1,237 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/dunstorm/pm2-go/ipc"
+	pb "github.com/dunstorm/pm2-go/proto"
+	"github.com/dunstorm/pm2-go/shared"
+	"github.com/dunstorm/pm2-go/utils"
+	"google.golang.org/grpc"
+)
+
+// metrics of the daemon itself
+type daemonMetrics struct {
+	mu            sync.Mutex
+	rpcDurations  map[string]*utils.Histogram
+	schedulerLoop *utils.Histogram
+}
+
+func newDaemonMetrics() *daemonMetrics {
+	return &daemonMetrics{
+		rpcDurations:  make(map[string]*utils.Histogram),
+		schedulerLoop: utils.NewHistogram(utils.DefaultBuckets),
+	}
+}
+
+func (m *daemonMetrics) rpcDuration(method string) *utils.Histogram {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	histogram := m.rpcDurations[method]
+	if histogram == nil {
+		histogram = utils.NewHistogram(utils.DefaultBuckets)
+		m.rpcDurations[method] = histogram
+	}
+	return histogram
+}
+
+// record the duration of every rpc
+func (api *Handler) observeRPC(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	api.metrics.rpcDuration(path.Base(info.FullMethod)).Observe(time.Since(start).Seconds())
+	return resp, err
+}
+
+func (api *Handler) metricsHandler() (http.Handler, error) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", api.serveMetrics)
+	return mux, nil
+}
+
+// snapshot of a process taken under the lock
+type processSample struct {
+	id       int32
+	process  *pb.Process
+	labels   []utils.Label
+	up       bool
+	uptime   float64
+	restarts int32
+	exitCode int32
+	logFiles []string
+	// cpu and memory of the last usage sample of the process
+	cpu     float64
+	memory  int64
+	sampled bool
+}
+
+func (api *Handler) serveMetrics(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	if err := api.writeMetrics(w); err != nil {
+		api.logger.Error().Msgf("Error while writing metrics: %s", err)
+	}
+}
+
+func (api *Handler) writeMetrics(w io.Writer) error {
+	api.mu.Lock()
+	samples := make([]processSample, 0, len(api.databaseById))
+	for _, p := range api.databaseById {
+		// processes added without being spawned have no namespace
+		namespace := p.Namespace
+		if namespace == "" {
+			namespace = shared.DefaultNamespace
+		}
+		sample := processSample{
+			id:      p.Id,
+			process: &pb.Process{Pid: p.Pid},
+			labels: []utils.Label{
+				{Name: "id", Value: strconv.Itoa(int(p.Id))},
+				{Name: "name", Value: p.Name},
+				{Name: "namespace", Value: namespace},
+			},
+			up:       p.ProcStatus.Status == "online" && p.Pid != 0,
+			restarts: p.ProcStatus.Restarts,
+			exitCode: p.ProcStatus.ExitCode,
+			logFiles: p.LogFiles(),
+		}
+		if sample.up {
+			sample.uptime = time.Since(p.ProcStatus.StartedAt.AsTime()).Seconds()
+			// the usage is sampled in the background, a scrape doesn't read it again
+			if history := api.histories[p.Id]; history != nil && len(history.samples) > 0 {
+				last := history.samples[len(history.samples)-1]
+				if !last.Time.AsTime().Before(p.ProcStatus.StartedAt.AsTime()) {
+					sample.cpu, sample.memory, sample.sampled = last.Cpu, last.Memory, true
+				}
+			}
+		}
+		samples = append(samples, sample)
+	}
+	api.mu.Unlock()
+	sort.Slice(samples, func(i, j int) bool {
+		return samples[i].id < samples[j].id
+	})
+
+	m := utils.NewMetricsWriter(w)
+	gauge := func(name string, help string, value func(sample processSample) float64) {
+		m.Family(name, "gauge", help)
+		for _, sample := range samples {
+			m.Sample(name, sample.labels, value(sample))
+		}
+	}
+	boolValue := func(b bool) float64 {
+		if b {
+			return 1
+		}
+		return 0
+	}
+
+	gauge("pm2_go_process_up", "Whether the process is online.", func(s processSample) float64 { return boolValue(s.up) })
+	m.Family("pm2_go_process_restarts_total", "counter", "Number of restarts of the process.")
+	for _, sample := range samples {
+		m.Sample("pm2_go_process_restarts_total", sample.labels, float64(sample.restarts))
+	}
+	gauge("pm2_go_process_uptime_seconds", "Time since the process started, 0 when it isn't online.", func(s processSample) float64 { return s.uptime })
+	gauge("pm2_go_process_last_exit_code", "Exit code of the last run of the process, 128 + the signal if it was killed by one.", func(s processSample) float64 { return float64(s.exitCode) })
+
+	// cpu and memory are only known for online processes once they were sampled
+	for _, family := range []struct {
+		name, help string
+		value      func(s processSample) float64
+	}{
+		{"pm2_go_process_cpu_percent", "CPU usage of the process in percent.", func(s processSample) float64 { return s.cpu }},
+		{"pm2_go_process_memory_bytes", "Resident memory of the process in bytes.", func(s processSample) float64 { return float64(s.memory) }},
+	} {
+		m.Family(family.name, "gauge", family.help)
+		for _, sample := range samples {
+			if sample.sampled {
+				m.Sample(family.name, sample.labels, family.value(sample))
+			}
+		}
+	}
+
+	gauge("pm2_go_process_log_bytes", "Size of the log files of the process in bytes.", func(s processSample) float64 {
+		size := int64(0)
+		for _, file := range s.logFiles {
+			if info, err := os.Stat(file); err == nil {
+				size += info.Size()
+			}
+		}
+		return float64(size)
+	})
+
+	api.writeAppMetrics(m, samples)
+	api.writeDaemonMetrics(m)
+	return m.Err()
+}
+
+// metrics sent by the processes over their ipc channel
+func (api *Handler) writeAppMetrics(m *utils.MetricsWriter, samples []processSample) {
+	metrics := make([][]*pb.Metric, len(samples))
+	for i, sample := range samples {
+		if !sample.up {
+			continue
+		}
+		if channel := shared.IPCChannelOf(sample.process.Pid); channel != nil {
+			metrics[i] = channel.Metrics()
+		}
+	}
+	withMetric := func(labels []utils.Label, name string) []utils.Label {
+		return append(labels[:len(labels):len(labels)], utils.Label{Name: "metric", Value: name})
+	}
+
+	// the samples of a family follow its header
+	for _, family := range []struct {
+		name, kind, help, metricType string
+	}{
+		{"pm2_go_app_counter_total", "counter", "Counter metrics sent by the processes.", ipc.MetricCounter},
+		{"pm2_go_app_gauge", "gauge", "Gauge metrics sent by the processes.", ipc.MetricGauge},
+		{"pm2_go_app_histogram", "summary", "Histogram metrics sent by the processes, quantiles are over the last observations.", ipc.MetricHistogram},
+	} {
+		m.Family(family.name, family.kind, family.help)
+		for i, sample := range samples {
+			for _, metric := range metrics[i] {
+				if metric.Type != family.metricType {
+					continue
+				}
+				labels := withMetric(sample.labels, metric.Name)
+				if metric.Type != ipc.MetricHistogram {
+					m.Sample(family.name, labels, metric.Value)
+					continue
+				}
+				for _, quantile := range []struct {
+					q     string
+					value float64
+				}{{"0.5", metric.P50}, {"0.95", metric.P95}, {"0.99", metric.P99}} {
+					m.Sample(family.name, append(labels[:len(labels):len(labels)], utils.Label{Name: "quantile", Value: quantile.q}), quantile.value)
+				}
+				m.Sample(family.name+"_sum", labels, metric.Sum)
+				m.Sample(family.name+"_count", labels, float64(metric.Count))
+			}
+		}
+	}
+}
+
+func (api *Handler) writeDaemonMetrics(m *utils.MetricsWriter) {
+	api.metrics.mu.Lock()
+	methods := make([]string, 0, len(api.metrics.rpcDurations))
+	for method := range api.metrics.rpcDurations {
+		methods = append(methods, method)
+	}
+	api.metrics.mu.Unlock()
+	sort.Strings(methods)
+
+	m.Family("pm2_go_rpc_duration_seconds", "histogram", "Duration of the RPCs served by the daemon.")
+	for _, method := range methods {
+		m.Histogram("pm2_go_rpc_duration_seconds", []utils.Label{{Name: "method", Value: method}}, api.metrics.rpcDuration(method).Snapshot())
+	}
+	m.Family("pm2_go_scheduler_loop_duration_seconds", "histogram", "Duration of the loop syncing the processes.")
+	m.Histogram("pm2_go_scheduler_loop_duration_seconds", nil, api.metrics.schedulerLoop.Snapshot())
+}
@@ -0,0 +1,47 @@
+package server
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/dunstorm/pm2-go/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestWriteMetrics(t *testing.T) {
+	api := newTestHandler(t)
+	startedAt := time.Now().Add(-time.Minute)
+	process := &pb.Process{
+		Id:        3,
+		Name:      "api",
+		Pid:       1,
+		Namespace: "default",
+		ProcStatus: &pb.ProcStatus{
+			Status:    "online",
+			StartedAt: timestamppb.New(startedAt),
+			Restarts:  2,
+		},
+	}
+	api.databaseById[process.Id] = process
+	api.processHistory(process.Id).samples = []*pb.UsageSample{
+		{Time: timestamppb.New(startedAt.Add(-time.Second)), Cpu: 90, Memory: 1},
+		{Time: timestamppb.New(startedAt.Add(time.Second)), Cpu: 12.5, Memory: 2048},
+	}
+
+	var out strings.Builder
+	if err := api.writeMetrics(&out); err != nil {
+		t.Fatal(err)
+	}
+	labels := `{id="3",name="api",namespace="default"}`
+	for _, line := range []string{
+		"# TYPE pm2_go_process_restarts_total counter",
+		"pm2_go_process_restarts_total" + labels + " 2",
+		"pm2_go_process_cpu_percent" + labels + " 12.5",
+		"pm2_go_process_memory_bytes" + labels + " 2048",
+	} {
+		if !strings.Contains(out.String(), line+"\n") {
+			t.Errorf("missing %q in\n%s", line, out.String())
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package server
+
+import (
+	"context"
+	"path/filepath"
+	"time"
+
+	pb "github.com/dunstorm/pm2-go/proto"
+	"github.com/dunstorm/pm2-go/shared"
+	"github.com/dunstorm/pm2-go/utils"
+	"github.com/fsnotify/fsnotify"
+	"google.golang.org/grpc/status"
+)
+
+// reload config
+func (api *Handler) ReloadConfig(ctx context.Context, in *pb.ReloadConfigRequest) (*pb.ReloadConfigResponse, error) {
+	if err := api.reloadConfig(); err != nil {
+		return nil, status.Errorf(400, "failed to reload config: %v", err)
+	}
+	return &pb.ReloadConfigResponse{
+		Success: true,
+	}, nil
+}
+
+func (api *Handler) reloadConfig() error {
+	config, err := utils.LoadConfig()
+	if err != nil {
+		api.logger.Error().Msgf("Error while reloading config: %s", err)
+		return err
+	}
+
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	if config != api.config {
+		previous := api.config
+		api.config = config
+		api.applyConfig(previous)
+		api.logger.Info().Msgf("Config reloaded")
+	}
+	api.applyServers()
+	return nil
+}
+
+// apply a reloaded config to the processes, api.mu is held. the scheduler reads
+// the config on every loop, the rotations it scheduled are based on the previous one
+func (api *Handler) applyConfig(previous utils.Config) {
+	for _, p := range api.databaseById {
+		config := logRotateConfig(api.config, p.LogRotate)
+		if config != logRotateConfig(previous, p.LogRotate) {
+			delete(api.logRotations, p.Id)
+		}
+
+		// the daemon only writes the log files of the processes spawned in reopen mode
+		if p.ProcStatus.Status != "online" || !config.LogRotate || config.LogRotateMode != utils.LogRotateModeReopen {
+			continue
+		}
+		if !shared.HasLogWriter(p.LogFilePath) {
+			api.logger.Warn().Msgf("Logs of %s are rotated with %s until it's restarted", p.Name, utils.LogRotateModeCopyTruncate)
+		}
+	}
+}
+
+// reload config whenever config.json changes
+func watchConfig(handler *Handler) {
+	configFile, err := utils.FindOrCreateConfigFile()
+	if err != nil {
+		handler.logger.Error().Msgf("Error while watching config: %s", err)
+		return
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		handler.logger.Error().Msgf("Error while watching config: %s", err)
+		return
+	}
+	// watch the directory, editors often replace the file instead of writing it
+	if err := watcher.Add(filepath.Dir(configFile)); err != nil {
+		handler.logger.Error().Msgf("Error while watching config: %s", err)
+		watcher.Close()
+		return
+	}
+
+	go func() {
+		defer watcher.Close()
+		// wait for writes to settle before reloading
+		var reload <-chan time.Time
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if filepath.Clean(event.Name) == filepath.Clean(configFile) && event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) != 0 {
+					reload = time.After(100 * time.Millisecond)
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				handler.logger.Error().Msgf("Error while watching config: %s", err)
+			case <-reload:
+				reload = nil
+				handler.reloadConfig()
+			}
+		}
+	}()
+}
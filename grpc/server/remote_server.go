@@ -0,0 +1,85 @@
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"os"
+
+	"github.com/dunstorm/pm2-go/utils"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// the grpc server for remote clients, it always uses tls and requires a token
+type remoteServer struct {
+	config   utils.Config
+	server   *grpc.Server
+	listener net.Listener
+}
+
+// tls config of the remote server, client certificates are required with tls_client_ca
+func remoteTLSConfig(config utils.Config) (*tls.Config, error) {
+	if config.TLSCert == "" || config.TLSKey == "" {
+		return nil, fmt.Errorf("remote_address requires tls_cert and tls_key")
+	}
+	certificate, err := tls.LoadX509KeyPair(config.TLSCert, config.TLSKey)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if config.TLSClientCA != "" {
+		content, err := os.ReadFile(config.TLSClientCA)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(content) {
+			return nil, fmt.Errorf("no certificate found in %s", config.TLSClientCA)
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return tlsConfig, nil
+}
+
+// start, stop or move the server following the config, api.mu is held
+func (s *remoteServer) apply(logger *zerolog.Logger, config utils.Config, newServer func(opts ...grpc.ServerOption) *grpc.Server) {
+	if config.RemoteAddress == s.config.RemoteAddress && config.TLSCert == s.config.TLSCert &&
+		config.TLSKey == s.config.TLSKey && config.TLSClientCA == s.config.TLSClientCA {
+		return
+	}
+	if s.server != nil {
+		// the address is released right away, the rpcs being served may need api.mu
+		s.listener.Close()
+		go s.server.GracefulStop()
+		s.server, s.listener = nil, nil
+		logger.Info().Msgf("Stopped serving remote GRPC server at %s", s.config.RemoteAddress)
+	}
+	s.config = utils.Config{}
+	if config.RemoteAddress == "" {
+		return
+	}
+
+	tlsConfig, err := remoteTLSConfig(config)
+	if err != nil {
+		logger.Error().Msgf("Error while serving remote GRPC server: %s", err)
+		return
+	}
+	lis, err := net.Listen("tcp", config.RemoteAddress)
+	if err != nil {
+		logger.Error().Msgf("Error while serving remote GRPC server: %s", err)
+		return
+	}
+	server := newServer(grpc.Creds(credentials.NewTLS(tlsConfig)))
+	go server.Serve(lis)
+
+	s.server, s.listener = server, lis
+	s.config = config
+	logger.Info().Msgf("Serving remote GRPC server at %s", lis.Addr())
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"net"
+	"testing"
+
+	"github.com/dunstorm/pm2-go/grpc/client"
+	pb "github.com/dunstorm/pm2-go/proto"
+	"github.com/dunstorm/pm2-go/utils"
+)
+
+// serve a handler with one process for remote clients, returns its context
+func serveRemoteHandler(t *testing.T, name string, token string) utils.ClientContext {
+	t.Helper()
+	api := newTestHandler(t)
+	api.tokens = tokens{admin: token, readOnly: token + "-read"}
+	process := &pb.Process{Id: 0, Name: name, ProcStatus: &pb.ProcStatus{Status: "stopped"}}
+	api.databaseById[process.Id] = process
+	api.databaseByName[process.Name] = process
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := api.newGRPCServer(true)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+	return utils.ClientContext{Host: lis.Addr().String(), Token: token, Insecure: true}
+}
+
+func TestRemoteContextsAreIsolated(t *testing.T) {
+	t.Setenv("PM2_GO_TOKEN", "")
+	config := utils.ClientConfig{Contexts: map[string]utils.ClientContext{
+		"staging":    serveRemoteHandler(t, "staging-app", "staging-token"),
+		"production": serveRemoteHandler(t, "production-app", "production-token"),
+	}}
+
+	for name, expected := range map[string]string{"staging": "staging-app", "production": "production-app"} {
+		context, err := config.Context(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c, err := client.NewRemote(context)
+		if err != nil {
+			t.Fatal(err)
+		}
+		processes := c.ListProcess()
+		if len(processes) != 1 || processes[0].Name != expected {
+			t.Errorf("expected only %s through %s, got %v", expected, name, processes)
+		}
+	}
+
+	// the token of a daemon isn't accepted by the other one
+	context, _ := config.Context("staging")
+	context.Token = "production-token"
+	c, err := client.NewRemote(context)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.ResolveTargets([]string{"all"}, nil); err == nil {
+		t.Error("expected the staging daemon to reject the production token")
+	}
+}
@@ -0,0 +1,121 @@
+package server
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	pb "github.com/dunstorm/pm2-go/proto"
+	"github.com/dunstorm/pm2-go/shared"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// resolve the targets of a command to copies of the processes, sorted by id.
+// the cpu and memory are the last ones listed, ps isn't run under the lock
+func (api *Handler) ResolveTargets(ctx context.Context, in *pb.ResolveTargetsRequest) (*pb.ResolveTargetsResponse, error) {
+	var requirements []shared.LabelRequirement
+	for _, selector := range in.Selectors {
+		parsed, err := shared.ParseSelector(selector)
+		if err != nil {
+			return nil, status.Errorf(400, "%v", err)
+		}
+		requirements = append(requirements, parsed...)
+	}
+
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	targets := in.Targets
+	if len(targets) == 0 {
+		targets = []string{"all"}
+	}
+	matched := map[int32]*pb.Process{}
+	for _, target := range targets {
+		processes, err := api.resolveTarget(target)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range processes {
+			matched[p.Id] = p
+		}
+	}
+
+	response := &pb.ResolveTargetsResponse{}
+	for _, p := range matched {
+		if matchesLabels(p, requirements) {
+			response.Processes = append(response.Processes, proto.Clone(p).(*pb.Process))
+		}
+	}
+	sort.Slice(response.Processes, func(i, j int) bool {
+		return response.Processes[i].Id < response.Processes[j].Id
+	})
+	return response, nil
+}
+
+// the processes of a target: all, a regex on the name, a name, an id, an id
+// range or a namespace, api.mu is held
+func (api *Handler) resolveTarget(target string) ([]*pb.Process, error) {
+	var processes []*pb.Process
+	if target == "all" {
+		for _, p := range api.databaseById {
+			processes = append(processes, p)
+		}
+		return processes, nil
+	}
+
+	if len(target) > 2 && strings.HasPrefix(target, "/") && strings.HasSuffix(target, "/") {
+		pattern, err := regexp.Compile(target[1 : len(target)-1])
+		if err != nil {
+			return nil, status.Errorf(400, "invalid regex %s: %v", target, err)
+		}
+		for _, p := range api.databaseById {
+			if pattern.MatchString(p.Name) {
+				processes = append(processes, p)
+			}
+		}
+		return processes, nil
+	}
+
+	if p, ok := api.databaseByName[target]; ok {
+		return []*pb.Process{p}, nil
+	}
+	if id, err := strconv.Atoi(target); err == nil {
+		if p, ok := api.databaseById[int32(id)]; ok {
+			return []*pb.Process{p}, nil
+		}
+		return nil, status.Errorf(codes.NotFound, "process %s not found", target)
+	}
+
+	if match := shared.IdRangePattern.FindStringSubmatch(target); match != nil {
+		from, _ := strconv.Atoi(match[1])
+		to, _ := strconv.Atoi(match[2])
+		for _, p := range api.databaseById {
+			if int(p.Id) >= from && int(p.Id) <= to {
+				processes = append(processes, p)
+			}
+		}
+	} else {
+		for _, p := range api.databaseById {
+			if p.Namespace == target {
+				processes = append(processes, p)
+			}
+		}
+	}
+	if len(processes) == 0 {
+		return nil, status.Errorf(codes.NotFound, "process or namespace %s not found", target)
+	}
+	return processes, nil
+}
+
+func matchesLabels(p *pb.Process, requirements []shared.LabelRequirement) bool {
+	for _, requirement := range requirements {
+		if !requirement.Matches(p.Labels) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,122 @@
+package server
+
+import (
+	"time"
+
+	"github.com/aptible/supercronic/cronexpr"
+	pb "github.com/dunstorm/pm2-go/proto"
+	"github.com/dunstorm/pm2-go/shared"
+	"github.com/dunstorm/pm2-go/utils"
+)
+
+// get the log rotation config of a process, with its overrides applied
+func logRotateConfig(config utils.Config, override *pb.LogRotate) utils.Config {
+	if override == nil {
+		return config
+	}
+	if override.Enabled != nil {
+		config.LogRotate = override.GetEnabled()
+	}
+	if override.Size > 0 {
+		config.LogRotateSize = int(override.Size)
+	}
+	if override.MaxFiles > 0 {
+		config.LogRotateMaxFiles = int(override.MaxFiles)
+	}
+	if override.Interval != "" {
+		config.LogRotateInterval = override.Interval
+	}
+	if override.Compress != nil {
+		config.LogRotateCompress = override.GetCompress()
+	}
+	if override.MaxAge != "" {
+		config.LogRotateMaxAge = override.MaxAge
+	}
+	return config
+}
+
+// next interval based rotation of a process
+type logRotation struct {
+	at       time.Time
+	interval string
+}
+
+// check if the rotation interval of a process elapsed, and schedule its next rotation
+func logRotationDue(handler *Handler, id int32, config utils.Config, now time.Time) bool {
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+	next, scheduled := handler.logRotations[id]
+	due := scheduled && !next.at.IsZero() && !now.Before(next.at)
+	if !scheduled || due || next.interval != config.LogRotateInterval {
+		handler.logRotations[id] = logRotation{
+			at:       nextLogRotationAt(config, now),
+			interval: config.LogRotateInterval,
+		}
+	}
+	return due
+}
+
+// get the next time logs are rotated by interval, zero if there is none
+func nextLogRotationAt(config utils.Config, now time.Time) time.Time {
+	if config.LogRotateInterval == "" {
+		return time.Time{}
+	}
+	expr, err := cronexpr.Parse(config.LogRotateInterval)
+	if err != nil {
+		return time.Time{}
+	}
+	return expr.Next(now)
+}
+
+// rotate the log files of a process exceeding the max size, or all of them
+// when the rotation interval elapsed, then apply the retention
+func rotateLogs(handler *Handler, p *pb.Process, config utils.Config, rotateByInterval bool) {
+	dateFormat := config.LogRotateDateFormat
+	if dateFormat == "" {
+		dateFormat = utils.DefaultLogRotateDateFormat
+	}
+	var maxAge time.Duration
+	if config.LogRotateMaxAge != "" {
+		var err error
+		if maxAge, err = utils.ParseDuration(config.LogRotateMaxAge); err != nil {
+			handler.logger.Error().Msgf("Invalid logrotate_max_age %s: %s", config.LogRotateMaxAge, err)
+		}
+	}
+
+	rotated := false
+	for _, logFile := range p.LogFiles() {
+		size := utils.FileSize(logFile)
+		if size == 0 {
+			continue
+		}
+		if !rotateByInterval && (config.LogRotateSize <= 0 || size <= int64(config.LogRotateSize)) {
+			continue
+		}
+
+		// reopen only works if the daemon is writing the file itself
+		var reopen func() error
+		if config.LogRotateMode == utils.LogRotateModeReopen && shared.HasLogWriter(logFile) {
+			reopen = func() error {
+				return shared.ReopenLogFile(logFile)
+			}
+		}
+
+		rotatedFile := utils.RotatedFilePath(logFile, time.Now(), dateFormat, config.LogRotateCompress)
+		if err := utils.RotateFile(logFile, rotatedFile, config.LogRotateCompress, reopen); err != nil {
+			handler.logger.Error().Msgf("Error while rotating log file %s: %s", logFile, err)
+			continue
+		}
+		handler.logger.Info().Msgf("Rotated log file %s to %s", logFile, rotatedFile)
+		rotated = true
+	}
+
+	if rotated {
+		p.LogFileCount++
+	}
+
+	for _, logFile := range p.LogFiles() {
+		for _, deleted := range utils.PruneRotatedFiles(logFile, config.LogRotateMaxFiles, maxAge) {
+			handler.logger.Info().Msgf("Deleted log file %s", deleted)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package server
+
+import (
+	"context"
+	"syscall"
+
+	pb "github.com/dunstorm/pm2-go/proto"
+	"github.com/dunstorm/pm2-go/utils"
+	"google.golang.org/grpc/status"
+)
+
+// send a signal to processes
+func (api *Handler) SignalProcess(ctx context.Context, in *pb.SignalProcessRequest) (*pb.SignalProcessResponse, error) {
+	signal, err := utils.ParseSignal(in.Signal)
+	if err != nil {
+		return nil, status.Errorf(400, "%v", err)
+	}
+
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	response := &pb.SignalProcessResponse{
+		Signal: utils.SignalName(signal),
+	}
+	for _, id := range in.Ids {
+		result := &pb.SignalProcessResult{Id: id}
+		response.Results = append(response.Results, result)
+
+		process := api.databaseById[id]
+		if process == nil {
+			result.Error = "process not found"
+			continue
+		}
+		result.Name = process.Name
+		result.Pid = process.Pid
+		if process.ProcStatus.Status != "online" || process.Pid == 0 {
+			result.Error = "process is not running"
+			continue
+		}
+
+		pid := int(process.Pid)
+		if in.Group {
+			pid = -pid
+		}
+		if err := syscall.Kill(pid, signal); err != nil {
+			result.Error = err.Error()
+			continue
+		}
+		result.Success = true
+		api.logger.Info().Msgf("sent %s to process %s (pid %d)", response.Signal, process.Name, process.Pid)
+	}
+	return response, nil
+}
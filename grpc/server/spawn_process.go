@@ -16,30 +16,82 @@ import (
 
 // spawn process
 func (api *Handler) SpawnProcess(ctx context.Context, in *pb.SpawnProcessRequest) (*pb.SpawnProcessResponse, error) {
+	if err := api.waitForDependencies(ctx, in.Name, in.DependsOn); err != nil {
+		return nil, status.Errorf(400, "%v", err)
+	}
+
+	var nextStartAt *timestamppb.Timestamp
+	if in.CronRestart != "" {
+		expr, err := cronexpr.Parse(in.CronRestart)
+		if err != nil {
+			return nil, status.Errorf(400, "Invalid cron expression: %v", err)
+		}
+		nextStartAt = timestamppb.New(expr.Next(time.Now()))
+	}
+
+	if err := shared.ValidateHealthCheck(in.HealthCheck); err != nil {
+		return nil, status.Errorf(400, "Invalid health check: %v", err)
+	}
+
+	if err := shared.ValidateHooks(in.Hooks); err != nil {
+		return nil, status.Errorf(400, "Invalid hooks: %v", err)
+	}
+
+	if in.Umask != "" {
+		if _, err := shared.ParseUmask(in.Umask); err != nil {
+			return nil, status.Errorf(400, "%v", err)
+		}
+	}
+
+	if err := shared.ValidateLimits(in.Limits); err != nil {
+		return nil, status.Errorf(400, "Invalid limits: %v", err)
+	}
+
+	if err := shared.ValidateNamespace(in.Namespace); err != nil {
+		return nil, status.Errorf(400, "%v", err)
+	}
+
+	if err := shared.ValidateLabels(in.Labels); err != nil {
+		return nil, status.Errorf(400, "%v", err)
+	}
+
+	api.mu.Lock()
+	_, err := api.startable(in)
+	api.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	// a failing pre_start hook aborts the start
+	if err := runPreStartHook(api, in); err != nil {
+		return nil, status.Errorf(400, "%v", err)
+	}
+
 	api.mu.Lock()
 	defer api.mu.Unlock()
 
+	// the process may have been started during the hook
+	existing, err := api.startable(in)
+	if err != nil {
+		return nil, err
+	}
+
+	id := api.nextId
+	if existing != nil {
+		id = existing.Id
+	}
+
 	// shared: spawn new process
-	process, err := shared.SpawnNewProcess(shared.SpawnParams{
-		Name:           in.Name,
-		Args:           in.Args,
-		ExecutablePath: in.ExecutablePath,
-		AutoRestart:    in.AutoRestart,
-		Logger:         api.logger,
-		Cwd:            in.Cwd,
-		Scripts:        in.Scripts,
-		CronRestart:    in.CronRestart,
-	})
+	process, err := shared.SpawnNewProcess(api.spawnParams(id, in))
 
 	if err != nil {
-		return &pb.SpawnProcessResponse{
-			Success: false,
-		}, nil
+		api.logger.Error().Msgf("failed to spawn process %s: %s", in.Name, err)
+		return nil, status.Errorf(400, "failed to spawn process: %v", err)
 	}
 
 	api.logger.Info().Msgf("spawned process: %d", process.Pid)
 
-	process.Id = api.nextId
+	process.Id = id
 	process.ProcStatus = &pb.ProcStatus{
 		Status:    "online",
 		StartedAt: timestamppb.New(time.Now()),
@@ -48,13 +100,14 @@ func (api *Handler) SpawnProcess(ctx context.Context, in *pb.SpawnProcessRequest
 		Memory:    "0.0MB",
 		ParentPid: int32(os.Getpid()),
 	}
+	process.NextStartAt = nextStartAt
+	process.ResetHealth()
 
-	if in.CronRestart != "" {
-		expr, err := cronexpr.Parse(in.CronRestart)
-		if err != nil {
-			return nil, status.Errorf(400, "Invalid cron expression: %v", err)
-		}
-		process.NextStartAt = timestamppb.New(expr.Next(time.Now()))
+	if existing != nil {
+		process.ProcStatus.Restarts = existing.ProcStatus.Restarts
+		process.LogFileCount = existing.LogFileCount
+		process.ProcStatus.ExitCode = existing.ProcStatus.ExitCode
+		delete(api.databaseByName, existing.Name)
 	}
 
 	osProcess, running := utils.GetProcess(process.Pid)
@@ -62,14 +115,48 @@ func (api *Handler) SpawnProcess(ctx context.Context, in *pb.SpawnProcessRequest
 		return nil, status.Error(400, "failed to spawn process")
 	}
 
-	api.databaseById[api.nextId] = process
+	api.databaseById[process.Id] = process
 	api.databaseByName[process.Name] = process
 	api.processes[process.Id] = osProcess
-	api.nextId++
+	if existing == nil {
+		api.nextId++
+	}
 
-	go osProcess.Wait()
+	api.recordEvent(process, eventStart, "")
+	waitProcess(api, process, osProcess)
 
 	return &pb.SpawnProcessResponse{
 		Success: true,
+		Process: process,
 	}, nil
 }
+
+// get the existing process with the name of a request, which is spawned again in place.
+// names and namespaces can't clash, so that a target is either an app or a namespace.
+// api.mu is held
+func (api *Handler) startable(in *pb.SpawnProcessRequest) (*pb.Process, error) {
+	existing := api.databaseByName[in.Name]
+	if existing != nil && existing.ProcStatus.Status == "online" {
+		return nil, status.Errorf(400, "process %s is already running", in.Name)
+	}
+	if existing != nil && api.terminating[existing.Id] {
+		return nil, status.Errorf(400, "process %s is still stopping", in.Name)
+	}
+
+	namespace := in.Namespace
+	if namespace == "" {
+		namespace = shared.DefaultNamespace
+	}
+	if namespace == in.Name {
+		return nil, status.Errorf(400, "process %s can't be named after its namespace", in.Name)
+	}
+	if p := api.databaseByName[namespace]; p != nil {
+		return nil, status.Errorf(400, "namespace %s is the name of process %d", namespace, p.Id)
+	}
+	for _, p := range api.databaseById {
+		if p != existing && p.Namespace == in.Name {
+			return nil, status.Errorf(400, "name %s is the namespace of process %d", in.Name, p.Id)
+		}
+	}
+	return existing, nil
+}
@@ -0,0 +1,29 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/dunstorm/pm2-go/proto"
+)
+
+func TestNamesAndNamespacesDontClash(t *testing.T) {
+	api := newBatchTestHandler(t)
+	ctx := context.Background()
+	web := sleepSpec("web")
+	web.Namespace = "frontend"
+	if _, err := api.SpawnProcess(ctx, web); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]*pb.SpawnProcessRequest{
+		"named after a namespace":   {Name: "frontend", ExecutablePath: "sleep", Args: []string{"30"}},
+		"in a namespace of an app":  {Name: "api", ExecutablePath: "sleep", Args: []string{"30"}, Namespace: "web"},
+		"named after its namespace": {Name: "jobs", ExecutablePath: "sleep", Args: []string{"30"}, Namespace: "jobs"},
+	}
+	for name, request := range cases {
+		if _, err := api.SpawnProcess(ctx, request); err == nil {
+			t.Errorf("expected an app %s to be rejected", name)
+		}
+	}
+}
@@ -1,9 +1,10 @@
 package server
 
 import (
+	"fmt"
 	"os"
-	"strconv"
 	"sync"
+	"syscall"
 	"time"
 
 	pb "github.com/dunstorm/pm2-go/proto"
@@ -15,19 +16,63 @@ func updateProcessMap(handler *Handler, processId int32, p *os.Process) {
 	handler.processes[processId] = p
 }
 
-func restartProcess(handler *Handler, p *pb.Process) {
+// get spawn params of a request for the process with an id, with the daemon settings applied
+func (handler *Handler) spawnParams(id int32, request *pb.SpawnProcessRequest) shared.SpawnParams {
+	params := shared.NewSpawnParams(request, handler.logger)
+	params.Id = id
+	config := logRotateConfig(handler.config, request.LogRotate)
+	params.PipeLogs = config.LogRotate && config.LogRotateMode == utils.LogRotateModeReopen
+	return params
+}
+
+// reap the process in the background and record its exit code
+func waitProcess(handler *Handler, p *pb.Process, process *os.Process) {
+	go func() {
+		state, err := process.Wait()
+		if err != nil {
+			return
+		}
+		exitCode := state.ExitCode()
+		if status, ok := state.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+			exitCode = 128 + int(status.Signal())
+		}
+		handler.mu.Lock()
+		p.ProcStatus.ExitCode = int32(exitCode)
+		// a process spawned again after a stop replaces this one, its stop is recorded
+		if handler.databaseById[p.Id] == p {
+			handler.recordEvent(p, eventExit, fmt.Sprintf("exit code %d", exitCode))
+		}
+		handler.mu.Unlock()
+	}()
+}
+
+// restart a process, reason is recorded in its history. handler.mu is held, it's
+// released while the pre_start hook runs
+func restartProcess(handler *Handler, p *pb.Process, reason string) {
+	// its previous process is still stopping
+	if handler.terminating[p.Id] {
+		return
+	}
 	handler.logger.Info().Msgf("Restarting process %s", p.Name)
+	request := p.SpawnRequest()
+	var err error
+	if p.Hooks.GetPreStart() != "" {
+		stopSignal := p.StopSignal
+		handler.mu.Unlock()
+		err = runPreStartHook(handler, request)
+		handler.mu.Lock()
+
+		// the process may have been deleted, started or stopped during the hook
+		if handler.databaseById[p.Id] != p || handler.processes[p.Id] != nil || (p.StopSignal && !stopSignal) {
+			return
+		}
+	}
+
 	p.IncreaseRestarts()
-	newProcess, err := shared.SpawnNewProcess(shared.SpawnParams{
-		Name:           p.Name,
-		Args:           p.Args,
-		ExecutablePath: p.ExecutablePath,
-		AutoRestart:    p.AutoRestart,
-		Cwd:            p.Cwd,
-		Logger:         handler.logger,
-		Scripts:        p.Scripts,
-		CronRestart:    p.CronRestart,
-	})
+	var newProcess *pb.Process
+	if err == nil {
+		newProcess, err = shared.SpawnNewProcess(handler.spawnParams(p.Id, request))
+	}
 	if err != nil {
 		p.AutoRestart = false
 		p.SetStopSignal(true)
@@ -36,11 +81,14 @@ func restartProcess(handler *Handler, p *pb.Process) {
 		updateProcessMap(handler, p.Id, nil)
 
 		handler.logger.Error().Msgf("Error while restarting process %s: %s", p.Name, err)
+		handler.recordEvent(p, eventRestart, fmt.Sprintf("%s, failed: %s", reason, err))
+		return
 	}
 
 	p.Pid = newProcess.Pid
 	p.ProcStatus.ParentPid = int32(os.Getpid())
 	p.UpdateStatus("online")
+	p.ResetHealth()
 
 	// set new process
 	process, _ := utils.GetProcess(p.Pid)
@@ -48,8 +96,9 @@ func restartProcess(handler *Handler, p *pb.Process) {
 
 	p.InitUptime()
 	p.InitStartedAt()
+	handler.recordEvent(p, eventRestart, reason)
 
-	go process.Wait()
+	waitProcess(handler, p, process)
 }
 
 func startScheduler(handler *Handler) {
@@ -57,11 +106,18 @@ func startScheduler(handler *Handler) {
 
 	// sync process
 	syncProcess := func(p *pb.Process) {
+		defer wg.Done()
 		if p.ProcStatus.Status == "online" {
-			if _, ok := utils.IsProcessRunning(p.Pid); !ok {
+			pid := p.Pid
+			if _, ok := utils.IsProcessRunning(pid); !ok {
 				handler.mu.Lock()
 				defer handler.mu.Unlock()
 
+				// the process may have been restarted meanwhile
+				if p.Pid != pid {
+					return
+				}
+
 				p.UpdateUptime()
 				p.ResetPid()
 				p.UpdateStatus("stopped")
@@ -70,80 +126,58 @@ func startScheduler(handler *Handler) {
 
 				// restart process if auto restart is enabled and process is not stopped
 				if p.AutoRestart && !p.GetStopSignal() {
-					restartProcess(handler, p)
+					restartProcess(handler, p, "exited")
 				}
 			} else {
 				p.UpdateUptime()
 			}
 		} else if p.NextStartAt != nil && p.NextStartAt.AsTime().Before(time.Now()) {
 			handler.logger.Debug().Msgf("Process %s is scheduled to start at %s", p.Name, p.NextStartAt.AsTime())
-			restartProcess(handler, p)
+			handler.mu.Lock()
+			restartProcess(handler, p, "cron")
 			p.UpdateNextStartAt()
+			handler.mu.Unlock()
 		}
-		wg.Done()
-	}
-
-	// read config
-	config := utils.GetConfig()
-
-	// handle max log file, max log size
-	handleMaxLog := func(p *pb.Process) {
-		defer wg.Done()
-		// if LogFilePath exceeds LogRotateSize, rename file and add logfilecount
-		combinedLogFilePath := utils.FileSize(p.LogFilePath) + utils.FileSize(p.ErrFilePath)
-		if config.LogRotate && combinedLogFilePath > int64(config.LogRotateSize) {
-			err := utils.RenameFile(p.LogFilePath, p.LogFilePath+"."+strconv.Itoa(int(p.LogFileCount)))
-			// if error rename file
-			if err != nil {
-				handler.logger.Error().Msgf("Error while renaming log file %s: %s", p.LogFilePath, err)
-			}
-			handler.logger.Info().Msgf("Renamed log file %s to %s", p.LogFilePath, p.LogFilePath+"."+strconv.Itoa(int(p.LogFileCount)))
-
-			// do the same for error log file
-			err = utils.RenameFile(p.ErrFilePath, p.ErrFilePath+"."+strconv.Itoa(int(p.LogFileCount)))
-			// if error rename file
-			if err != nil {
-				handler.logger.Error().Msgf("Error while renaming log file %s: %s", p.ErrFilePath, err)
-			}
-			handler.logger.Info().Msgf("Renamed err file %s to %s", p.ErrFilePath, p.ErrFilePath+"."+strconv.Itoa(int(p.LogFileCount)))
-
-			// if no error, increase logfilecount
-			p.LogFileCount++
-
-			// if LogFileCount exceeds LogRotateCount, delete oldest log file
-			if p.LogFileCount >= int32(config.LogRotateMaxFiles) {
-				// delete oldest log & err file
-				err = os.Remove(p.LogFilePath + "." + strconv.Itoa(int(p.LogFileCount-int32(config.LogRotateMaxFiles))))
-				if err != nil {
-					handler.logger.Error().Msgf("Error while deleting log file %s: %s", p.LogFilePath+"."+strconv.Itoa(int(p.LogFileCount-int32(config.LogRotateMaxFiles))), err)
-				}
-				handler.logger.Info().Msgf("Deleted log file %s", p.LogFilePath+"."+strconv.Itoa(int(p.LogFileCount-int32(config.LogRotateMaxFiles))))
-
-				err = os.Remove(p.ErrFilePath + "." + strconv.Itoa(int(p.LogFileCount-int32(config.LogRotateMaxFiles))))
-				if err != nil {
-					handler.logger.Error().Msgf("Error while deleting log file %s: %s", p.ErrFilePath+"."+strconv.Itoa(int(p.LogFileCount-int32(config.LogRotateMaxFiles))), err)
-				}
-				handler.logger.Info().Msgf("Deleted err file %s", p.ErrFilePath+"."+strconv.Itoa(int(p.LogFileCount-int32(config.LogRotateMaxFiles))))
-
-				// decrease logfilecount
-				p.LogFileCount = int32(config.LogRotateMaxFiles)
-			}
-		}
-
 	}
 
 	go func() {
 		for {
+			// read config, it may have been reloaded
+			handler.mu.Lock()
+			config := handler.config
+			handler.mu.Unlock()
+
+			now := time.Now()
 			for _, p := range handler.databaseById {
 				wg.Add(1)
 				go syncProcess(p)
 
-				if config.LogRotate {
+				// apply per process overrides
+				processConfig := logRotateConfig(config, p.LogRotate)
+				if processConfig.LogRotate {
+					rotateByInterval := logRotationDue(handler, p.Id, processConfig, now)
 					wg.Add(1)
-					go handleMaxLog(p)
+					go func(p *pb.Process) {
+						defer wg.Done()
+						rotateLogs(handler, p, processConfig, rotateByInterval)
+					}(p)
 				}
 			}
 			wg.Wait()
+
+			// health checks run in the background, they may take up to their timeout
+			handler.mu.Lock()
+			for _, p := range handler.databaseById {
+				if p.HealthCheck == nil {
+					continue
+				}
+				if handler.healthChecks[p.Id] == nil {
+					handler.healthChecks[p.Id] = &healthCheckState{}
+				}
+				scheduleHealthCheck(handler, p, handler.healthChecks[p.Id], now)
+			}
+			handler.mu.Unlock()
+			handler.metrics.schedulerLoop.Observe(time.Since(now).Seconds())
 			time.Sleep(500 * time.Millisecond)
 		}
 	}()
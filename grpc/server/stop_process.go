@@ -3,54 +3,188 @@ package server
 import (
 	"context"
 	"syscall"
+	"time"
 
 	pb "github.com/dunstorm/pm2-go/proto"
+	"github.com/dunstorm/pm2-go/shared"
+	"github.com/dunstorm/pm2-go/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
+// processes are asked to exit rather than killed outright, their last output
+// still goes through the log pipelines of the daemon
+const stopSignal = syscall.SIGTERM
+
+// time given to a process to exit before it gets killed
+const killTimeout = 1600 * time.Millisecond
+
+// the processes stopped along with an app. it leads its own process group as it's
+// spawned with Setpgid, the group is signaled at once so that the processes forked
+// meanwhile get the signal too. its descendants are only looked up in /proc when it
+// left its process group
+type processTree struct {
+	pid         int32
+	group       bool
+	cgroup      string
+	descendants []int32
+}
+
+func newProcessTree(p *pb.Process, pid int32) *processTree {
+	tree := &processTree{pid: pid}
+	if !p.KillsTree() {
+		return tree
+	}
+	tree.cgroup = p.Cgroup
+	if pgid, err := syscall.Getpgid(int(pid)); err == nil && pgid != int(pid) {
+		tree.descendants = utils.ProcessTree(pid)
+	} else {
+		tree.group = true
+	}
+	return tree
+}
+
+// signal the processes, the ones which are already gone are ignored
+func (t *processTree) signal(signal syscall.Signal) error {
+	target := int(t.pid)
+	if t.group {
+		target = -target
+	}
+	err := syscall.Kill(target, signal)
+	if err == syscall.ESRCH {
+		err = nil
+	}
+	utils.SignalProcesses(t.descendants, signal)
+	if t.cgroup != "" {
+		// the processes of a cgroup can't leave it
+		if signal != syscall.SIGKILL || shared.KillCgroup(t.cgroup) != nil {
+			utils.SignalProcesses(shared.CgroupProcesses(t.cgroup), signal)
+		}
+	}
+	return err
+}
+
+func (t *processTree) alive() bool {
+	alive := utils.IsProcessAlive(t.pid)
+	if t.group {
+		alive = utils.IsProcessGroupAlive(t.pid)
+	}
+	if alive {
+		return true
+	}
+	if len(utils.WaitForExit(t.descendants, 0)) > 0 {
+		return true
+	}
+	return len(shared.CgroupProcesses(t.cgroup)) > 0
+}
+
+// wait for the processes to exit, false if some are still running after timeout
+func (t *processTree) waitForExit(timeout time.Duration) bool {
+	interval := 50 * time.Millisecond
+	for t.alive() {
+		if timeout <= 0 {
+			return false
+		}
+		time.Sleep(interval)
+		timeout -= interval
+	}
+	return true
+}
+
+// stop a process and, with treekill, its process group and cgroup. the ones still
+// running after killTimeout are killed, then checked to be gone
+func terminateProcess(handler *Handler, p *pb.Process, pid int32) error {
+	tree := newProcessTree(p, pid)
+	err := tree.signal(stopSignal)
+	if tree.waitForExit(killTimeout) {
+		return err
+	}
+	handler.logger.Info().Msgf("killing the remaining processes of %s", p.Name)
+	tree.signal(syscall.SIGKILL)
+	if !tree.waitForExit(killTimeout) {
+		handler.logger.Warn().Msgf("processes of %s are still running", p.Name)
+	}
+	return err
+}
+
+// terminate a process, api.mu is held and released meanwhile so that the daemon keeps
+// replying. the process isn't spawned again until it's gone
+func (api *Handler) terminateUnlocked(p *pb.Process, pid int32) error {
+	api.terminating[p.Id] = true
+	process := proto.Clone(p).(*pb.Process)
+	api.mu.Unlock()
+	err := terminateProcess(api, process, pid)
+	api.mu.Lock()
+	delete(api.terminating, p.Id)
+	return err
+}
+
 // stop process
 func (api *Handler) StopProcess(ctx context.Context, in *pb.StopProcessRequest) (*pb.StopProcessResponse, error) {
 	api.mu.Lock()
-	defer api.mu.Unlock()
-
 	process := api.databaseById[in.Id]
-	found := api.processes[in.Id]
+	if process == nil {
+		api.mu.Unlock()
+		return nil, status.Errorf(codes.NotFound, "process %d not found", in.Id)
+	}
+	return api.stopProcess(process)
+}
 
-	process.SetStatus("stopped")
-	process.ResetCPUMemory()
+// stop a process the caller looked up with api.mu held. the lock is released
+// around the hooks of the process and when it returns
+func (api *Handler) stopProcess(process *pb.Process) (*pb.StopProcessResponse, error) {
+	found := api.processes[process.Id]
+
+	// the process isn't restarted once it exits from now on
 	process.StopSignal = true
 
 	if found == nil {
-		api.logger.Info().Msgf("process not found: %d", in.Id)
+		process.SetStatus("stopped")
+		process.ResetCPUMemory()
+		api.mu.Unlock()
+		api.logger.Info().Msgf("process not found: %d", process.Id)
 		return &pb.StopProcessResponse{
 			Success: false,
 		}, nil
 	}
 
-	if process.ProcStatus.ParentPid == 1 {
-		api.logger.Info().Msgf("sending stop signal to: %d", found.Pid)
-
-		// kill process which have parent pid 1
-		err := found.Signal(syscall.SIGTERM)
-		if err != nil {
-			api.logger.Info().Msgf("failed to stop process: %s", err.Error())
-			updateProcessMap(api, in.Id, nil)
+	api.recordEvent(process, eventStop, "")
+	hookProcess := proto.Clone(process).(*pb.Process)
+	api.mu.Unlock()
+	runPreStopHook(api, hookProcess)
+	defer runPostStopHook(api, hookProcess)
 
-			return &pb.StopProcessResponse{
-				Success: false,
-			}, nil
-		}
-		process.ResetPid()
+	api.mu.Lock()
+	defer api.mu.Unlock()
 
+	// the process may have been deleted, or have exited, during the hook
+	if api.databaseById[process.Id] != process {
+		return nil, status.Errorf(codes.NotFound, "process %s was deleted while stopping", process.Name)
+	}
+	process.SetStatus("stopped")
+	process.ResetCPUMemory()
+	found = api.processes[process.Id]
+	if found == nil {
 		return &pb.StopProcessResponse{
 			Success: true,
 		}, nil
 	}
 
+	// give the process some time to exit gracefully
+	api.logger.Info().Msgf("sending stop signal to: %d", found.Pid)
 	process.ResetPid()
-
-	// for child process
-	found.Kill()
-	updateProcessMap(api, in.Id, nil)
+	updateProcessMap(api, process.Id, nil)
+	err := api.terminateUnlocked(process, int32(found.Pid))
+	if api.databaseById[process.Id] != process {
+		return nil, status.Errorf(codes.NotFound, "process %s was deleted while stopping", process.Name)
+	}
+	if err != nil {
+		api.logger.Info().Msgf("failed to stop process: %s", err.Error())
+		return &pb.StopProcessResponse{
+			Success: false,
+		}, nil
+	}
 
 	return &pb.StopProcessResponse{
 		Success: true,
@@ -0,0 +1,101 @@
+package server
+
+import (
+	"context"
+	"os/exec"
+	"syscall"
+	"testing"
+	"time"
+
+	pb "github.com/dunstorm/pm2-go/proto"
+	"github.com/dunstorm/pm2-go/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTerminateProcessGroup(t *testing.T) {
+	api := newTestHandler(t)
+	// forks more processes when asked to stop, they are killed along with it
+	cmd := exec.Command("/bin/sh", "-c", "trap 'sleep 30 & sleep 30 &' TERM; sleep 30 & while true; do sleep 0.1; done")
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	pid := int32(cmd.Process.Pid)
+	defer syscall.Kill(-int(pid), syscall.SIGKILL)
+	go cmd.Wait()
+	// let the shell set its trap
+	time.Sleep(200 * time.Millisecond)
+
+	if err := terminateProcess(api, &pb.Process{Name: "tree"}, pid); err != nil {
+		t.Fatal(err)
+	}
+	if utils.IsProcessGroupAlive(pid) {
+		t.Error("processes of the group are still running")
+	}
+}
+
+func TestTerminateProcessWithoutTreeKill(t *testing.T) {
+	api := newTestHandler(t)
+	cmd := exec.Command("/bin/sh", "-c", "sleep 30 & wait")
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	pid := int32(cmd.Process.Pid)
+	defer syscall.Kill(-int(pid), syscall.SIGKILL)
+	go cmd.Wait()
+	// let the shell fork its child
+	time.Sleep(200 * time.Millisecond)
+
+	treeKill := false
+	if err := terminateProcess(api, &pb.Process{Name: "leader", TreeKill: &treeKill}, pid); err != nil {
+		t.Fatal(err)
+	}
+	if utils.IsProcessAlive(pid) {
+		t.Error("the process is still running")
+	}
+	if !utils.IsProcessGroupAlive(pid) {
+		t.Error("the children of the process were stopped without treekill")
+	}
+}
+
+func TestStopAndDeleteUnknownProcess(t *testing.T) {
+	api := newTestHandler(t)
+	ctx := context.Background()
+	if _, err := api.StopProcess(ctx, &pb.StopProcessRequest{Id: 42}); status.Code(err) != codes.NotFound {
+		t.Errorf("expected stopping an unknown process to be NotFound, got %v", err)
+	}
+	if _, err := api.DeleteProcess(ctx, &pb.DeleteProcessRequest{Id: 42}); status.Code(err) != codes.NotFound {
+		t.Errorf("expected deleting an unknown process to be NotFound, got %v", err)
+	}
+}
+
+func TestStopDoesntBlockRequests(t *testing.T) {
+	api := newBatchTestHandler(t)
+	ctx := context.Background()
+	// ignores the stop signal, it's killed after killTimeout
+	response, err := api.SpawnProcess(ctx, &pb.SpawnProcessRequest{
+		Name:           "stubborn",
+		ExecutablePath: "sh",
+		Args:           []string{"-c", "trap '' TERM; while true; do sleep 0.1; done"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(200 * time.Millisecond)
+
+	stopped := make(chan error, 1)
+	go func() {
+		_, err := api.StopProcess(ctx, &pb.StopProcessRequest{Id: response.Process.Id})
+		stopped <- err
+	}()
+	time.Sleep(200 * time.Millisecond)
+	assertListsWhileRunning(t, api, stopped)
+	if _, err := api.SpawnProcess(ctx, &pb.SpawnProcessRequest{Name: "stubborn", ExecutablePath: "sleep", Args: []string{"30"}}); err == nil {
+		t.Error("the process was spawned again while it was stopping")
+	}
+	if err := <-stopped; err != nil {
+		t.Fatal(err)
+	}
+}
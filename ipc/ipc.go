@@ -0,0 +1,169 @@
+// Package ipc lets an app started by pm2-go talk to the daemon.
+//
+// The daemon passes one end of a unix socket to the app, its fd is in the PM2_GO_IPC_FD
+// environment variable. Messages are json objects, one per line, so apps in any
+// language can implement the protocol:
+//
+//	app to daemon: {"type":"action","name":"clear-cache","description":"..."}
+//	daemon to app: {"type":"trigger","id":1,"name":"clear-cache","param":"..."}
+//	app to daemon: {"type":"reply","id":1,"data":<json>} or {"type":"reply","id":1,"error":"..."}
+//	app to daemon: {"type":"metric","name":"queue length","kind":"gauge","value":12}
+//
+// A counter metric adds its value to the total, a gauge sets it and a histogram
+// records it as an observation.
+package ipc
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+)
+
+const (
+	FdEnv = "PM2_GO_IPC_FD"
+
+	// app to daemon: register an action
+	MessageAction = "action"
+	// daemon to app: trigger an action, app to daemon: its reply
+	MessageTrigger = "trigger"
+	MessageReply   = "reply"
+	// app to daemon: record a metric
+	MessageMetric = "metric"
+
+	// kinds of metrics
+	MetricCounter   = "counter"
+	MetricGauge     = "gauge"
+	MetricHistogram = "histogram"
+)
+
+type Message struct {
+	Type string `json:"type"`
+	// request id of a trigger and its reply
+	Id int64 `json:"id,omitempty"`
+
+	// action
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Param       string `json:"param,omitempty"`
+
+	// reply
+	Data  json.RawMessage `json:"data,omitempty"`
+	Error string          `json:"error,omitempty"`
+
+	// metric
+	Kind  string  `json:"kind,omitempty"`
+	Value float64 `json:"value,omitempty"`
+}
+
+// ErrNotManaged is returned when the app wasn't started by pm2-go
+var ErrNotManaged = errors.New("not started by pm2-go")
+
+// ActionHandler handles a triggered action, its result is sent as json
+type ActionHandler func(param string) (any, error)
+
+var (
+	mu       sync.Mutex
+	conn     net.Conn
+	connErr  error
+	connOnce sync.Once
+	handlers = make(map[string]ActionHandler)
+)
+
+// connect to the daemon once, then read its messages in the background
+func connect() (net.Conn, error) {
+	connOnce.Do(func() {
+		fd, err := strconv.Atoi(os.Getenv(FdEnv))
+		if err != nil {
+			connErr = ErrNotManaged
+			return
+		}
+		file := os.NewFile(uintptr(fd), "pm2-go-ipc")
+		defer file.Close()
+		if conn, connErr = net.FileConn(file); connErr != nil {
+			return
+		}
+		go read(conn)
+	})
+	return conn, connErr
+}
+
+func read(conn net.Conn) {
+	scanner := bufio.NewScanner(conn)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var message Message
+		if err := json.Unmarshal(scanner.Bytes(), &message); err != nil {
+			continue
+		}
+		if message.Type == MessageTrigger {
+			go trigger(message)
+		}
+	}
+}
+
+// run the handler of a triggered action and send its reply
+func trigger(message Message) {
+	mu.Lock()
+	handler := handlers[message.Name]
+	mu.Unlock()
+
+	reply := Message{Type: MessageReply, Id: message.Id}
+	if handler == nil {
+		reply.Error = fmt.Sprintf("unknown action %s", message.Name)
+		Send(reply)
+		return
+	}
+	data, err := handler(message.Param)
+	if err == nil {
+		reply.Data, err = json.Marshal(data)
+	}
+	if err != nil {
+		reply.Data = nil
+		reply.Error = err.Error()
+	}
+	Send(reply)
+}
+
+// Send sends a message to the daemon
+func Send(message Message) error {
+	conn, err := connect()
+	if err != nil {
+		return err
+	}
+	content, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	_, err = conn.Write(append(content, '\n'))
+	return err
+}
+
+// Action registers an action which can be triggered with pm2-go trigger
+func Action(name string, description string, handler ActionHandler) error {
+	mu.Lock()
+	handlers[name] = handler
+	mu.Unlock()
+	return Send(Message{Type: MessageAction, Name: name, Description: description})
+}
+
+// Counter adds delta to a counter metric, e.g. the number of requests
+func Counter(name string, delta float64) error {
+	return Send(Message{Type: MessageMetric, Name: name, Kind: MetricCounter, Value: delta})
+}
+
+// Gauge sets a gauge metric, e.g. a queue length
+func Gauge(name string, value float64) error {
+	return Send(Message{Type: MessageMetric, Name: name, Kind: MetricGauge, Value: value})
+}
+
+// Histogram records an observation of a histogram metric, e.g. a request duration
+func Histogram(name string, value float64) error {
+	return Send(Message{Type: MessageMetric, Name: name, Kind: MetricHistogram, Value: value})
+}
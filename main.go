@@ -4,8 +4,13 @@ Copyright © 2022 NAME HERE <EMAIL ADDRESS>
 */
 package main
 
-import "github.com/dunstorm/pm2-go/cmd"
+import (
+	"github.com/dunstorm/pm2-go/cmd"
+	"github.com/dunstorm/pm2-go/shared"
+)
 
 func main() {
+	// processes with rlimits are started through pm2-go itself
+	shared.RunExecShim()
 	cmd.Execute()
 }
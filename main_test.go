@@ -51,7 +51,7 @@ func TestSpawn(t *testing.T) {
 
 func TestStartEcosystem(t *testing.T) {
 	master := app.New()
-	err := master.StartFile("examples/ecosystem.json")
+	err := master.StartFile("examples/ecosystem.json", "", false)
 	if err != nil {
 		t.Error(err)
 	}
@@ -71,7 +71,7 @@ func TestStopEcosystem(t *testing.T) {
 	master := app.New()
 	pythonTestPid := master.FindProcess("python-test").Pid
 	celeryWorkerPid := master.FindProcess("celery-worker").Pid
-	err := master.StopFile("examples/ecosystem.json")
+	err := master.StopFile("examples/ecosystem.json", false)
 	if err != nil {
 		t.Error(err)
 	}
@@ -89,7 +89,7 @@ func TestStopEcosystem(t *testing.T) {
 
 func TestDeleteEcosystem(t *testing.T) {
 	master := app.New()
-	err := master.DeleteFile("examples/ecosystem.json")
+	err := master.DeleteFile("examples/ecosystem.json", false)
 	if err != nil {
 		t.Error(err)
 	}
@@ -126,7 +126,7 @@ func TestCronRestart(t *testing.T) {
 	if process.NextStartAt == nil {
 		t.Error("Cron expression failed, NextStartAt is nil")
 	}
-	c.StopProcess(process.Id)
+	c.StopProcess(process)
 	c.DeleteProcess(process.Id)
 }
 
@@ -150,7 +150,7 @@ func TestNoCronRestart(t *testing.T) {
 	if process.NextStartAt != nil {
 		t.Error("NextStartAt is not nil")
 	}
-	c.StopProcess(process.Id)
+	c.StopProcess(process)
 	c.DeleteProcess(process.Id)
 }
 
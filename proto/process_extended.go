@@ -2,6 +2,7 @@ package __
 
 import (
 	"fmt"
+	"os"
 	"os/exec"
 	"strconv"
 	"strings"
@@ -50,31 +51,51 @@ func (p *Process) ResetRestarts() {
 	p.ProcStatus.Restarts = 0
 }
 
+// health is unknown until the first health check of the process
+func (p *Process) ResetHealth() {
+	p.ProcStatus.Health = ""
+	p.ProcStatus.HealthFailures = 0
+	if p.HealthCheck != nil {
+		p.ProcStatus.Health = "starting"
+	}
+}
+
 func (p *Process) ResetCPUMemory() {
 	p.ProcStatus.Cpu = "0.0%"
 	p.ProcStatus.Memory = "0.0MB"
 }
 
-func (p *Process) UpdateCPUMemory() {
+// get the cpu usage in percent and the resident memory in bytes of the process
+func (p *Process) CPUMemory() (float64, int64, bool) {
 	if p.Pid == 0 {
-		return
+		return 0, 0, false
 	}
 	// launch command and read content
 	cmd := exec.Command("ps", "-p", fmt.Sprintf("%d", p.Pid), "-o", "pcpu,rss")
 	output, err := cmd.Output()
 	if err != nil {
-		return
+		return 0, 0, false
 	}
 	// output separator can be multiple whitespaces
 	// fix: error `parsing "": invalid syntax` in `strconv.ParseFloat`
 	outputSplit := strings.Fields(strings.TrimSpace(strings.Split(string(output), "\n")[1]))
-
-	p.ProcStatus.Cpu = fmt.Sprint(outputSplit[0], "%")
+	if len(outputSplit) < 2 {
+		return 0, 0, false
+	}
 
 	// convert string to float
-	memory, _ := strconv.ParseFloat(outputSplit[1], 64)
-	memory = memory / 1024
-	p.ProcStatus.Memory = fmt.Sprintf("%.1fMB", memory)
+	cpu, _ := strconv.ParseFloat(outputSplit[0], 64)
+	rss, _ := strconv.ParseInt(outputSplit[1], 10, 64)
+	return cpu, rss * 1024, true
+}
+
+func (p *Process) UpdateCPUMemory() {
+	cpu, memory, ok := p.CPUMemory()
+	if !ok {
+		return
+	}
+	p.ProcStatus.Cpu = fmt.Sprint(strconv.FormatFloat(cpu, 'f', 1, 64), "%")
+	p.ProcStatus.Memory = fmt.Sprintf("%.1fMB", float64(memory)/1024/1024)
 }
 
 func (p *Process) UpdateNextStartAt() error {
@@ -88,3 +109,71 @@ func (p *Process) UpdateNextStartAt() error {
 	}
 	return nil
 }
+
+// build a spawn request to start the process again with the same parameters
+func (p *Process) SpawnRequest() *SpawnProcessRequest {
+	return &SpawnProcessRequest{
+		Name:            p.Name,
+		Args:            p.Args,
+		ExecutablePath:  p.ExecutablePath,
+		AutoRestart:     p.AutoRestart,
+		Cwd:             p.Cwd,
+		Scripts:         p.Scripts,
+		CronRestart:     p.CronRestart,
+		LogTransformers: p.LogTransformers,
+		OutFile:         p.LogFilePath,
+		ErrorFile:       p.ErrFilePath,
+		LogFile:         p.CombinedFilePath,
+		MergeLogs:       p.MergeLogs,
+		LogRotate:       p.LogRotate,
+		HealthCheck:     p.HealthCheck,
+		DependsOn:       p.DependsOn,
+		Hooks:           p.Hooks,
+		User:            p.User,
+		Group:           p.Group,
+		Umask:           p.Umask,
+		Limits:          p.Limits,
+		TreeKill:        p.TreeKill,
+		Namespace:       p.Namespace,
+		Labels:          p.Labels,
+		Env:             p.Env,
+	}
+}
+
+// check if stopping the process stops its descendants as well
+func (p *Process) KillsTree() bool {
+	return p.TreeKill == nil || *p.TreeKill
+}
+
+// get the distinct log files written by the process, disabled streams are skipped
+func (p *Process) LogFiles() []string {
+	var files []string
+	for _, file := range []string{p.LogFilePath, p.ErrFilePath, p.CombinedFilePath} {
+		if file == "" || file == os.DevNull {
+			continue
+		}
+		duplicate := false
+		for _, f := range files {
+			if f == file {
+				duplicate = true
+			}
+		}
+		if !duplicate {
+			files = append(files, file)
+		}
+	}
+	return files
+}
+
+// describe what the health check probes
+func (h *HealthCheck) Target() string {
+	switch h.Type {
+	case "http":
+		return fmt.Sprintf("GET %s (%d)", h.Url, h.Status)
+	case "tcp":
+		return h.Address
+	case "exec":
+		return h.Command
+	}
+	return ""
+}
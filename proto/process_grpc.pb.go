@@ -29,6 +29,15 @@ type ProcessManagerClient interface {
 	DeleteProcess(ctx context.Context, in *DeleteProcessRequest, opts ...grpc.CallOption) (*DeleteProcessResponse, error)
 	ListProcess(ctx context.Context, in *ListProcessRequest, opts ...grpc.CallOption) (*ListProcessResponse, error)
 	SpawnProcess(ctx context.Context, in *SpawnProcessRequest, opts ...grpc.CallOption) (*SpawnProcessResponse, error)
+	ReloadConfig(ctx context.Context, in *ReloadConfigRequest, opts ...grpc.CallOption) (*ReloadConfigResponse, error)
+	SignalProcess(ctx context.Context, in *SignalProcessRequest, opts ...grpc.CallOption) (*SignalProcessResponse, error)
+	ListActions(ctx context.Context, in *ListActionsRequest, opts ...grpc.CallOption) (*ListActionsResponse, error)
+	TriggerAction(ctx context.Context, in *TriggerActionRequest, opts ...grpc.CallOption) (*TriggerActionResponse, error)
+	GetMetrics(ctx context.Context, in *GetMetricsRequest, opts ...grpc.CallOption) (*GetMetricsResponse, error)
+	GetProcessHistory(ctx context.Context, in *GetProcessHistoryRequest, opts ...grpc.CallOption) (*GetProcessHistoryResponse, error)
+	StreamLogs(ctx context.Context, in *StreamLogsRequest, opts ...grpc.CallOption) (ProcessManager_StreamLogsClient, error)
+	ResolveTargets(ctx context.Context, in *ResolveTargetsRequest, opts ...grpc.CallOption) (*ResolveTargetsResponse, error)
+	BatchApply(ctx context.Context, in *BatchApplyRequest, opts ...grpc.CallOption) (*BatchApplyResponse, error)
 }
 
 type processManagerClient struct {
@@ -102,6 +111,110 @@ func (c *processManagerClient) SpawnProcess(ctx context.Context, in *SpawnProces
 	return out, nil
 }
 
+func (c *processManagerClient) ReloadConfig(ctx context.Context, in *ReloadConfigRequest, opts ...grpc.CallOption) (*ReloadConfigResponse, error) {
+	out := new(ReloadConfigResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProcessManager/ReloadConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processManagerClient) SignalProcess(ctx context.Context, in *SignalProcessRequest, opts ...grpc.CallOption) (*SignalProcessResponse, error) {
+	out := new(SignalProcessResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProcessManager/SignalProcess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processManagerClient) ListActions(ctx context.Context, in *ListActionsRequest, opts ...grpc.CallOption) (*ListActionsResponse, error) {
+	out := new(ListActionsResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProcessManager/ListActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processManagerClient) TriggerAction(ctx context.Context, in *TriggerActionRequest, opts ...grpc.CallOption) (*TriggerActionResponse, error) {
+	out := new(TriggerActionResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProcessManager/TriggerAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processManagerClient) GetMetrics(ctx context.Context, in *GetMetricsRequest, opts ...grpc.CallOption) (*GetMetricsResponse, error) {
+	out := new(GetMetricsResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProcessManager/GetMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processManagerClient) GetProcessHistory(ctx context.Context, in *GetProcessHistoryRequest, opts ...grpc.CallOption) (*GetProcessHistoryResponse, error) {
+	out := new(GetProcessHistoryResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProcessManager/GetProcessHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processManagerClient) StreamLogs(ctx context.Context, in *StreamLogsRequest, opts ...grpc.CallOption) (ProcessManager_StreamLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ProcessManager_ServiceDesc.Streams[0], "/proto.ProcessManager/StreamLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &processManagerStreamLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ProcessManager_StreamLogsClient interface {
+	Recv() (*LogLine, error)
+	grpc.ClientStream
+}
+
+type processManagerStreamLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *processManagerStreamLogsClient) Recv() (*LogLine, error) {
+	m := new(LogLine)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *processManagerClient) ResolveTargets(ctx context.Context, in *ResolveTargetsRequest, opts ...grpc.CallOption) (*ResolveTargetsResponse, error) {
+	out := new(ResolveTargetsResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProcessManager/ResolveTargets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processManagerClient) BatchApply(ctx context.Context, in *BatchApplyRequest, opts ...grpc.CallOption) (*BatchApplyResponse, error) {
+	out := new(BatchApplyResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProcessManager/BatchApply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProcessManagerServer is the server API for ProcessManager service.
 // All implementations must embed UnimplementedProcessManagerServer
 // for forward compatibility
@@ -113,6 +226,15 @@ type ProcessManagerServer interface {
 	DeleteProcess(context.Context, *DeleteProcessRequest) (*DeleteProcessResponse, error)
 	ListProcess(context.Context, *ListProcessRequest) (*ListProcessResponse, error)
 	SpawnProcess(context.Context, *SpawnProcessRequest) (*SpawnProcessResponse, error)
+	ReloadConfig(context.Context, *ReloadConfigRequest) (*ReloadConfigResponse, error)
+	SignalProcess(context.Context, *SignalProcessRequest) (*SignalProcessResponse, error)
+	ListActions(context.Context, *ListActionsRequest) (*ListActionsResponse, error)
+	TriggerAction(context.Context, *TriggerActionRequest) (*TriggerActionResponse, error)
+	GetMetrics(context.Context, *GetMetricsRequest) (*GetMetricsResponse, error)
+	GetProcessHistory(context.Context, *GetProcessHistoryRequest) (*GetProcessHistoryResponse, error)
+	StreamLogs(*StreamLogsRequest, ProcessManager_StreamLogsServer) error
+	ResolveTargets(context.Context, *ResolveTargetsRequest) (*ResolveTargetsResponse, error)
+	BatchApply(context.Context, *BatchApplyRequest) (*BatchApplyResponse, error)
 	mustEmbedUnimplementedProcessManagerServer()
 }
 
@@ -141,6 +263,33 @@ func (UnimplementedProcessManagerServer) ListProcess(context.Context, *ListProce
 func (UnimplementedProcessManagerServer) SpawnProcess(context.Context, *SpawnProcessRequest) (*SpawnProcessResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SpawnProcess not implemented")
 }
+func (UnimplementedProcessManagerServer) ReloadConfig(context.Context, *ReloadConfigRequest) (*ReloadConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadConfig not implemented")
+}
+func (UnimplementedProcessManagerServer) SignalProcess(context.Context, *SignalProcessRequest) (*SignalProcessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalProcess not implemented")
+}
+func (UnimplementedProcessManagerServer) ListActions(context.Context, *ListActionsRequest) (*ListActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListActions not implemented")
+}
+func (UnimplementedProcessManagerServer) TriggerAction(context.Context, *TriggerActionRequest) (*TriggerActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerAction not implemented")
+}
+func (UnimplementedProcessManagerServer) GetMetrics(context.Context, *GetMetricsRequest) (*GetMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
+}
+func (UnimplementedProcessManagerServer) GetProcessHistory(context.Context, *GetProcessHistoryRequest) (*GetProcessHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProcessHistory not implemented")
+}
+func (UnimplementedProcessManagerServer) StreamLogs(*StreamLogsRequest, ProcessManager_StreamLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamLogs not implemented")
+}
+func (UnimplementedProcessManagerServer) ResolveTargets(context.Context, *ResolveTargetsRequest) (*ResolveTargetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveTargets not implemented")
+}
+func (UnimplementedProcessManagerServer) BatchApply(context.Context, *BatchApplyRequest) (*BatchApplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchApply not implemented")
+}
 func (UnimplementedProcessManagerServer) mustEmbedUnimplementedProcessManagerServer() {}
 
 // UnsafeProcessManagerServer may be embedded to opt out of forward compatibility for this service.
@@ -280,6 +429,171 @@ func _ProcessManager_SpawnProcess_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProcessManager_ReloadConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessManagerServer).ReloadConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProcessManager/ReloadConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessManagerServer).ReloadConfig(ctx, req.(*ReloadConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessManager_SignalProcess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignalProcessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessManagerServer).SignalProcess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProcessManager/SignalProcess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessManagerServer).SignalProcess(ctx, req.(*SignalProcessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessManager_ListActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessManagerServer).ListActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProcessManager/ListActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessManagerServer).ListActions(ctx, req.(*ListActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessManager_TriggerAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessManagerServer).TriggerAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProcessManager/TriggerAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessManagerServer).TriggerAction(ctx, req.(*TriggerActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessManager_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessManagerServer).GetMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProcessManager/GetMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessManagerServer).GetMetrics(ctx, req.(*GetMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessManager_GetProcessHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProcessHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessManagerServer).GetProcessHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProcessManager/GetProcessHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessManagerServer).GetProcessHistory(ctx, req.(*GetProcessHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessManager_StreamLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProcessManagerServer).StreamLogs(m, &processManagerStreamLogsServer{stream})
+}
+
+type ProcessManager_StreamLogsServer interface {
+	Send(*LogLine) error
+	grpc.ServerStream
+}
+
+type processManagerStreamLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *processManagerStreamLogsServer) Send(m *LogLine) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ProcessManager_ResolveTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveTargetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessManagerServer).ResolveTargets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProcessManager/ResolveTargets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessManagerServer).ResolveTargets(ctx, req.(*ResolveTargetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessManager_BatchApply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchApplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessManagerServer).BatchApply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProcessManager/BatchApply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessManagerServer).BatchApply(ctx, req.(*BatchApplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProcessManager_ServiceDesc is the grpc.ServiceDesc for ProcessManager service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -315,7 +629,45 @@ var ProcessManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SpawnProcess",
 			Handler:    _ProcessManager_SpawnProcess_Handler,
 		},
+		{
+			MethodName: "ReloadConfig",
+			Handler:    _ProcessManager_ReloadConfig_Handler,
+		},
+		{
+			MethodName: "SignalProcess",
+			Handler:    _ProcessManager_SignalProcess_Handler,
+		},
+		{
+			MethodName: "ListActions",
+			Handler:    _ProcessManager_ListActions_Handler,
+		},
+		{
+			MethodName: "TriggerAction",
+			Handler:    _ProcessManager_TriggerAction_Handler,
+		},
+		{
+			MethodName: "GetMetrics",
+			Handler:    _ProcessManager_GetMetrics_Handler,
+		},
+		{
+			MethodName: "GetProcessHistory",
+			Handler:    _ProcessManager_GetProcessHistory_Handler,
+		},
+		{
+			MethodName: "ResolveTargets",
+			Handler:    _ProcessManager_ResolveTargets_Handler,
+		},
+		{
+			MethodName: "BatchApply",
+			Handler:    _ProcessManager_BatchApply_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamLogs",
+			Handler:       _ProcessManager_StreamLogs_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "process.proto",
 }
@@ -0,0 +1,10 @@
+package shared
+
+// actions of the items of a batch
+const (
+	// start the app of a spec, or spawn it again with it when it exists
+	BatchStart   = "start"
+	BatchStop    = "stop"
+	BatchRestart = "restart"
+	BatchDelete  = "delete"
+)
@@ -0,0 +1,192 @@
+package shared
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+
+	pb "github.com/dunstorm/pm2-go/proto"
+)
+
+// period of cpu.max, in microseconds
+const cgroupCpuPeriod = 100000
+
+var (
+	cgroupMu sync.Mutex
+	// set once the cgroups are prepared, a failure is retried by the next process
+	cgroupDir string
+)
+
+// find where cgroup v2 is mounted
+func cgroupMountPoint() (string, error) {
+	file, err := os.Open("/proc/self/mountinfo")
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		// the filesystem type follows the " - " separator
+		fields := strings.Fields(scanner.Text())
+		for i, field := range fields {
+			if field == "-" && i+1 < len(fields) && fields[i+1] == "cgroup2" {
+				return fields[4], nil
+			}
+		}
+	}
+	return "", errors.New("cgroup v2 is not mounted")
+}
+
+// get the cgroup v2 directory of the daemon
+func ownCgroupDir() (string, error) {
+	mountPoint, err := cgroupMountPoint()
+	if err != nil {
+		return "", err
+	}
+	content, err := os.ReadFile("/proc/self/cgroup")
+	if err != nil {
+		return "", err
+	}
+	for _, line := range strings.Split(string(content), "\n") {
+		if path, ok := strings.CutPrefix(line, "0::"); ok {
+			return filepath.Join(mountPoint, path), nil
+		}
+	}
+	return "", errors.New("the daemon isn't in a cgroup v2")
+}
+
+// prepare the cgroup of the daemon to hold the cgroups of the processes. as a cgroup with
+// controllers enabled can't hold processes itself, the daemon and the processes it started
+// without limits move into a leaf cgroup, and back if the controllers can't be enabled
+func setupCgroups() (string, error) {
+	dir, err := ownCgroupDir()
+	if err != nil {
+		return "", err
+	}
+	controllers, err := os.ReadFile(filepath.Join(dir, "cgroup.controllers"))
+	if err != nil {
+		return "", err
+	}
+	for _, controller := range []string{"cpu", "memory"} {
+		if !strings.Contains(" "+strings.TrimSpace(string(controllers))+" ", " "+controller+" ") {
+			return "", fmt.Errorf("the %s controller isn't available in %s", controller, dir)
+		}
+	}
+
+	daemonDir := filepath.Join(dir, "daemon")
+	if err := os.MkdirAll(daemonDir, 0755); err != nil {
+		return "", err
+	}
+	pids := CgroupProcesses(dir)
+	if err := moveToCgroup(daemonDir, pids); err != nil {
+		moveToCgroup(dir, pids)
+		return "", fmt.Errorf("failed to move the daemon into %s: %v", daemonDir, err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "cgroup.subtree_control"), []byte("+cpu +memory"), 0644); err != nil {
+		moveToCgroup(dir, pids)
+		return "", fmt.Errorf("failed to enable the cpu and memory controllers in %s: %v", dir, err)
+	}
+	return dir, nil
+}
+
+// move processes into a cgroup, the ones which exited meanwhile are skipped
+func moveToCgroup(dir string, pids []int32) error {
+	for _, pid := range pids {
+		err := os.WriteFile(filepath.Join(dir, "cgroup.procs"), []byte(strconv.Itoa(int(pid))), 0644)
+		if err != nil && !errors.Is(err, syscall.ESRCH) {
+			return err
+		}
+	}
+	return nil
+}
+
+// get the cgroup holding the cgroups of the processes, prepared the first time
+func cgroupsDir() (string, error) {
+	cgroupMu.Lock()
+	defer cgroupMu.Unlock()
+	if cgroupDir != "" {
+		return cgroupDir, nil
+	}
+	dir, err := setupCgroups()
+	if err != nil {
+		return "", err
+	}
+	cgroupDir = dir
+	return dir, nil
+}
+
+// check if the process needs a cgroup
+func hasCgroupLimits(limits *pb.Limits) bool {
+	return limits.GetCpu() > 0 || limits.GetMemory() > 0
+}
+
+// create the cgroup of a process with its cpu.max and memory.max, named after its id. the
+// returned directory is to be passed as CgroupFD so that the process starts inside of it
+func openCgroup(id int32, limits *pb.Limits) (*os.File, error) {
+	parent, err := cgroupsDir()
+	if err != nil {
+		return nil, err
+	}
+
+	dir := filepath.Join(parent, fmt.Sprintf("app-%d", id))
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	cpuMax := "max"
+	if limits.GetCpu() > 0 {
+		cpuMax = fmt.Sprint(int64(limits.GetCpu() * cgroupCpuPeriod))
+	}
+	if err := os.WriteFile(filepath.Join(dir, "cpu.max"), []byte(fmt.Sprintf("%s %d", cpuMax, cgroupCpuPeriod)), 0644); err != nil {
+		return nil, fmt.Errorf("failed to set cpu.max: %v", err)
+	}
+	memoryMax := "max"
+	if limits.GetMemory() > 0 {
+		memoryMax = fmt.Sprint(limits.GetMemory())
+	}
+	if err := os.WriteFile(filepath.Join(dir, "memory.max"), []byte(memoryMax), 0644); err != nil {
+		return nil, fmt.Errorf("failed to set memory.max: %v", err)
+	}
+	return os.Open(dir)
+}
+
+// kill every process of a cgroup at once, including the ones forked meanwhile.
+// cgroup.kill needs linux 5.14
+func KillCgroup(dir string) error {
+	return os.WriteFile(filepath.Join(dir, "cgroup.kill"), []byte("1"), 0644)
+}
+
+// remove the cgroup of a process, it fails while processes are left in it
+func RemoveCgroup(dir string) error {
+	if dir == "" {
+		return nil
+	}
+	if err := os.Remove(dir); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// get the processes of a cgroup
+func CgroupProcesses(dir string) []int32 {
+	if dir == "" {
+		return nil
+	}
+	content, err := os.ReadFile(filepath.Join(dir, "cgroup.procs"))
+	if err != nil {
+		return nil
+	}
+	var pids []int32
+	for _, line := range strings.Fields(string(content)) {
+		if pid, err := strconv.Atoi(line); err == nil {
+			pids = append(pids, int32(pid))
+		}
+	}
+	return pids
+}
@@ -0,0 +1,106 @@
+package shared
+
+import (
+	"fmt"
+	"os"
+	"os/user"
+	"strconv"
+	"syscall"
+)
+
+// look up a user by name or id
+func lookupUser(name string) (*user.User, error) {
+	u, err := user.Lookup(name)
+	if err == nil {
+		return u, nil
+	}
+	if _, convErr := strconv.Atoi(name); convErr == nil {
+		return user.LookupId(name)
+	}
+	return nil, err
+}
+
+// look up a group id by name or id
+func lookupGroupId(name string) (uint32, error) {
+	g, err := user.LookupGroup(name)
+	if err != nil {
+		if g, err = user.LookupGroupId(name); err != nil {
+			if id, convErr := strconv.ParseUint(name, 10, 32); convErr == nil {
+				return uint32(id), nil
+			}
+			return 0, fmt.Errorf("unknown group %s", name)
+		}
+	}
+	id, err := strconv.ParseUint(g.Gid, 10, 32)
+	return uint32(id), err
+}
+
+// get the credential to run a process as, nil if neither user nor group is set
+func LookupCredential(userName string, groupName string) (*syscall.Credential, error) {
+	if userName == "" && groupName == "" {
+		return nil, nil
+	}
+
+	credential := &syscall.Credential{
+		Uid: uint32(os.Getuid()),
+		Gid: uint32(os.Getgid()),
+	}
+	if userName != "" {
+		u, err := lookupUser(userName)
+		if err != nil {
+			return nil, fmt.Errorf("unknown user %s", userName)
+		}
+		uid, _ := strconv.ParseUint(u.Uid, 10, 32)
+		gid, _ := strconv.ParseUint(u.Gid, 10, 32)
+		credential.Uid, credential.Gid = uint32(uid), uint32(gid)
+
+		// supplementary groups of the user
+		groupIds, _ := u.GroupIds()
+		for _, groupId := range groupIds {
+			if id, err := strconv.ParseUint(groupId, 10, 32); err == nil {
+				credential.Groups = append(credential.Groups, uint32(id))
+			}
+		}
+	}
+	if groupName != "" {
+		gid, err := lookupGroupId(groupName)
+		if err != nil {
+			return nil, err
+		}
+		credential.Gid = gid
+	}
+
+	if os.Geteuid() != 0 {
+		if credential.Uid != uint32(os.Geteuid()) || credential.Gid != uint32(os.Getegid()) {
+			return nil, fmt.Errorf("running as user %q group %q requires the daemon to run as root", userName, groupName)
+		}
+		// only root can set the supplementary groups
+		credential.NoSetGroups = true
+	}
+	return credential, nil
+}
+
+// parse an octal umask, e.g. 027
+func ParseUmask(umask string) (int, error) {
+	mask, err := strconv.ParseUint(umask, 8, 32)
+	if err != nil || mask > 0777 {
+		return 0, fmt.Errorf("invalid umask %q, expected an octal mode e.g. 022", umask)
+	}
+	return int(mask), nil
+}
+
+// give the files to the user the process runs as
+func chownFiles(credential *syscall.Credential, filePaths ...string) error {
+	if credential == nil {
+		return nil
+	}
+	for _, filePath := range filePaths {
+		if filePath == "" || filePath == os.DevNull {
+			continue
+		}
+		if err := os.Chown(filePath, int(credential.Uid), int(credential.Gid)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
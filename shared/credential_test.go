@@ -0,0 +1,47 @@
+package shared
+
+import (
+	"os"
+	"os/user"
+	"testing"
+)
+
+func TestLookupCredential(t *testing.T) {
+	if credential, err := LookupCredential("", ""); credential != nil || err != nil {
+		t.Errorf("expected no credential, got %v %v", credential, err)
+	}
+	if _, err := LookupCredential("no-such-user", ""); err == nil {
+		t.Error("expected an unknown user error")
+	}
+	if _, err := LookupCredential("", "no-such-group"); err == nil {
+		t.Error("expected an unknown group error")
+	}
+
+	if _, err := user.Lookup("nobody"); err != nil {
+		t.Skip("no nobody user")
+	}
+	credential, err := LookupCredential("nobody", "")
+	if os.Geteuid() != 0 {
+		if err == nil {
+			t.Error("expected an error without privilege")
+		}
+		return
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	if credential.Uid == 0 {
+		t.Errorf("unexpected credential %+v", credential)
+	}
+}
+
+func TestParseUmask(t *testing.T) {
+	if mask, err := ParseUmask("027"); err != nil || mask != 027 {
+		t.Errorf("unexpected umask %o %v", mask, err)
+	}
+	for _, umask := range []string{"999", "1777", "rw"} {
+		if _, err := ParseUmask(umask); err == nil {
+			t.Errorf("expected an error for %s", umask)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os/exec"
+	"strings"
+	"syscall"
+	"time"
+
+	pb "github.com/dunstorm/pm2-go/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	HealthCheckHTTP = "http"
+	HealthCheckTCP  = "tcp"
+	HealthCheckExec = "exec"
+
+	DefaultHealthCheckInterval = 30 * time.Second
+	DefaultHealthCheckTimeout  = 5 * time.Second
+	DefaultHealthCheckRetries  = 3
+	DefaultHealthCheckStatus   = http.StatusOK
+)
+
+// fill the unset values of a health check with their default
+func HealthCheckWithDefaults(check *pb.HealthCheck) *pb.HealthCheck {
+	if check == nil {
+		return nil
+	}
+	check = proto.Clone(check).(*pb.HealthCheck)
+	if check.Interval == nil || check.Interval.AsDuration() <= 0 {
+		check.Interval = durationpb.New(DefaultHealthCheckInterval)
+	}
+	if check.Timeout == nil || check.Timeout.AsDuration() <= 0 {
+		check.Timeout = durationpb.New(DefaultHealthCheckTimeout)
+	}
+	if check.Retries <= 0 {
+		check.Retries = DefaultHealthCheckRetries
+	}
+	if check.Type == HealthCheckHTTP && check.Status == 0 {
+		check.Status = DefaultHealthCheckStatus
+	}
+	return check
+}
+
+// check the health check has what its type needs
+func ValidateHealthCheck(check *pb.HealthCheck) error {
+	if check == nil {
+		return nil
+	}
+	switch check.Type {
+	case HealthCheckHTTP:
+		if !strings.HasPrefix(check.Url, "http://") && !strings.HasPrefix(check.Url, "https://") {
+			return fmt.Errorf("http health check needs an http(s) url, got %q", check.Url)
+		}
+	case HealthCheckTCP:
+		if _, _, err := net.SplitHostPort(check.Address); err != nil {
+			return fmt.Errorf("tcp health check needs a host:port address: %v", err)
+		}
+	case HealthCheckExec:
+		if check.Command == "" {
+			return fmt.Errorf("exec health check needs a command")
+		}
+	default:
+		return fmt.Errorf("unknown health check type %q, expected %s, %s or %s", check.Type, HealthCheckHTTP, HealthCheckTCP, HealthCheckExec)
+	}
+	return nil
+}
+
+// run a health check once, nil means healthy. exec checks run as the user of the
+// process if the credential is set
+func RunHealthCheck(check *pb.HealthCheck, cwd string, credential *syscall.Credential) error {
+	check = HealthCheckWithDefaults(check)
+	ctx, cancel := context.WithTimeout(context.Background(), check.Timeout.AsDuration())
+	defer cancel()
+
+	switch check.Type {
+	case HealthCheckHTTP:
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, check.Url, nil)
+		if err != nil {
+			return err
+		}
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			return err
+		}
+		response.Body.Close()
+		if response.StatusCode != int(check.Status) {
+			return fmt.Errorf("GET %s returned %d, expected %d", check.Url, response.StatusCode, check.Status)
+		}
+		return nil
+	case HealthCheckTCP:
+		var dialer net.Dialer
+		conn, err := dialer.DialContext(ctx, "tcp", check.Address)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	case HealthCheckExec:
+		cmd := exec.CommandContext(ctx, "/bin/sh", "-c", check.Command)
+		cmd.Dir = cwd
+		cmd.SysProcAttr = &syscall.SysProcAttr{Credential: credential}
+		// don't wait for children of the command holding its output
+		cmd.WaitDelay = time.Second
+		output, err := cmd.CombinedOutput()
+		if ctx.Err() != nil {
+			return fmt.Errorf("command timed out after %s", check.Timeout.AsDuration())
+		}
+		if err != nil {
+			return fmt.Errorf("%v: %s", err, strings.TrimSpace(string(output)))
+		}
+		return nil
+	}
+	return ValidateHealthCheck(check)
+}
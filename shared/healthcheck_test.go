@@ -0,0 +1,62 @@
+package shared
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	pb "github.com/dunstorm/pm2-go/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestRunHealthCheck(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/health" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	closed := listener.Addr().String()
+	listener.Close()
+
+	timeout := durationpb.New(500 * time.Millisecond)
+	checks := []struct {
+		check   *pb.HealthCheck
+		healthy bool
+	}{
+		{&pb.HealthCheck{Type: "http", Url: server.URL + "/health"}, true},
+		{&pb.HealthCheck{Type: "http", Url: server.URL + "/"}, false},
+		{&pb.HealthCheck{Type: "tcp", Address: server.Listener.Addr().String()}, true},
+		{&pb.HealthCheck{Type: "tcp", Address: closed}, false},
+		{&pb.HealthCheck{Type: "exec", Command: "exit 0"}, true},
+		{&pb.HealthCheck{Type: "exec", Command: "exit 1"}, false},
+		{&pb.HealthCheck{Type: "exec", Command: "sleep 5", Timeout: timeout}, false},
+	}
+	for _, c := range checks {
+		err := RunHealthCheck(c.check, "", nil)
+		if healthy := err == nil; healthy != c.healthy {
+			t.Errorf("%s %s: expected healthy %v, got %v", c.check.Type, c.check.Target(), c.healthy, err)
+		}
+	}
+}
+
+func TestInvalidHealthCheck(t *testing.T) {
+	checks := []*pb.HealthCheck{
+		{Type: "ping"},
+		{Type: "http", Url: "localhost:8080"},
+		{Type: "tcp", Address: "localhost"},
+		{Type: "exec"},
+	}
+	for _, check := range checks {
+		if err := ValidateHealthCheck(check); err == nil {
+			t.Errorf("expected an error for %v", check)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package shared
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"syscall"
+	"time"
+
+	pb "github.com/dunstorm/pm2-go/proto"
+	"github.com/rs/zerolog"
+)
+
+const (
+	HookPreStart  = "pre_start"
+	HookPostStart = "post_start"
+	HookPreStop   = "pre_stop"
+	HookPostStop  = "post_stop"
+
+	DefaultHookTimeout = 30 * time.Second
+	// longest timeout a hook can have, callers waiting for a hook rely on it
+	MaxHookTimeout = 5 * time.Minute
+)
+
+// get the command of a hook, empty if it isn't set
+func hookCommand(hooks *pb.Hooks, hook string) string {
+	switch hook {
+	case HookPreStart:
+		return hooks.GetPreStart()
+	case HookPostStart:
+		return hooks.GetPostStart()
+	case HookPreStop:
+		return hooks.GetPreStop()
+	case HookPostStop:
+		return hooks.GetPostStop()
+	}
+	return ""
+}
+
+// get the timeout of the hooks of a process
+func HookTimeout(hooks *pb.Hooks) time.Duration {
+	if hooks.GetTimeout() == nil || hooks.GetTimeout().AsDuration() <= 0 {
+		return DefaultHookTimeout
+	}
+	return hooks.GetTimeout().AsDuration()
+}
+
+func ValidateHooks(hooks *pb.Hooks) error {
+	if timeout := HookTimeout(hooks); timeout > MaxHookTimeout {
+		return fmt.Errorf("hook timeout %s is longer than %s", timeout, MaxHookTimeout)
+	}
+	return nil
+}
+
+// run a hook of a process with /bin/sh in its working directory, as its user if the
+// credential is set. its output is logged line by line. does nothing if the hook isn't set
+func RunHook(hooks *pb.Hooks, hook string, name string, cwd string, credential *syscall.Credential, logger *zerolog.Logger) error {
+	command := hookCommand(hooks, hook)
+	if command == "" {
+		return nil
+	}
+	timeout := HookTimeout(hooks)
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	logger.Info().Msgf("[%s] running %s hook: %s", name, hook, command)
+
+	reader, writer := io.Pipe()
+	cmd := exec.CommandContext(ctx, "/bin/sh", "-c", command)
+	cmd.Dir = cwd
+	cmd.Env = append(os.Environ(), "PM2_GO_APP_NAME="+name, "PM2_GO_HOOK="+hook)
+	cmd.SysProcAttr = &syscall.SysProcAttr{Credential: credential}
+	cmd.Stdout = writer
+	cmd.Stderr = writer
+	// don't wait for children of the hook holding its output
+	cmd.WaitDelay = time.Second
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		scanner := bufio.NewScanner(reader)
+		for scanner.Scan() {
+			logger.Info().Msgf("[%s] %s: %s", name, hook, scanner.Text())
+		}
+		io.Copy(io.Discard, reader)
+	}()
+
+	err := cmd.Run()
+	writer.Close()
+	<-done
+
+	if ctx.Err() == context.DeadlineExceeded {
+		return fmt.Errorf("%s hook of %s timed out after %s", hook, name, timeout)
+	}
+	if err != nil {
+		return fmt.Errorf("%s hook of %s failed: %v", hook, name, err)
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package shared
+
+import (
+	"fmt"
+	"os"
+	"os/user"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/dunstorm/pm2-go/proto"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestRunHook(t *testing.T) {
+	logger := zerolog.Nop()
+	hooks := &pb.Hooks{
+		PreStart: "exit 0",
+		PreStop:  "echo failing; exit 2",
+		PostStop: "sleep 5",
+		Timeout:  durationpb.New(200 * time.Millisecond),
+	}
+
+	if err := RunHook(hooks, HookPreStart, "app", "", nil, &logger); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+	// unset hooks do nothing
+	if err := RunHook(hooks, HookPostStart, "app", "", nil, &logger); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+	if err := RunHook(hooks, HookPreStop, "app", "", nil, &logger); err == nil || !strings.Contains(err.Error(), "failed") {
+		t.Errorf("expected a failed hook, got %v", err)
+	}
+	if err := RunHook(hooks, HookPostStop, "app", "", nil, &logger); err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Errorf("expected a timed out hook, got %v", err)
+	}
+}
+
+func TestRunHookAsUser(t *testing.T) {
+	if os.Geteuid() != 0 {
+		t.Skip("running as another user requires root")
+	}
+	if _, err := user.Lookup("nobody"); err != nil {
+		t.Skip("no nobody user")
+	}
+	credential, err := LookupCredential("nobody", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger := zerolog.Nop()
+	hooks := &pb.Hooks{PreStart: fmt.Sprintf("test $(id -u) = %d", credential.Uid)}
+	if err := RunHook(hooks, HookPreStart, "app", "/", credential, &logger); err != nil {
+		t.Errorf("expected the hook to run as nobody, got %v", err)
+	}
+	check := &pb.HealthCheck{Type: "exec", Command: hooks.PreStart}
+	if err := RunHealthCheck(check, "/", credential); err != nil {
+		t.Errorf("expected the health check to run as nobody, got %v", err)
+	}
+}
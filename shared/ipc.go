@@ -0,0 +1,197 @@
+package shared
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sort"
+	"sync"
+	"syscall"
+
+	"github.com/dunstorm/pm2-go/ipc"
+	"github.com/rs/zerolog"
+)
+
+// fd of the ipc channel in the process, the first extra file
+const ipcFd = 3
+
+type IPCAction struct {
+	Name        string
+	Description string
+}
+
+// IPCChannel is the daemon end of the ipc channel of a process
+type IPCChannel struct {
+	conn   net.Conn
+	logger *zerolog.Logger
+
+	mu      sync.Mutex
+	actions map[string]IPCAction
+	pending map[int64]chan ipc.Message
+	metrics map[string]*metric
+	nextId  int64
+	closed  bool
+}
+
+// ipc channels by pid
+var (
+	ipcChannelsMu sync.Mutex
+	ipcChannels   = make(map[int32]*IPCChannel)
+)
+
+// get the ipc channel of a process, nil if it has none
+func IPCChannelOf(pid int32) *IPCChannel {
+	ipcChannelsMu.Lock()
+	defer ipcChannelsMu.Unlock()
+	return ipcChannels[pid]
+}
+
+// create an ipc channel, the returned file is the end to pass to the process
+func newIPCChannel(logger *zerolog.Logger) (*IPCChannel, *os.File, error) {
+	fds, err := syscall.Socketpair(syscall.AF_UNIX, syscall.SOCK_STREAM|syscall.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	daemonFile := os.NewFile(uintptr(fds[0]), "ipc")
+	defer daemonFile.Close()
+	conn, err := net.FileConn(daemonFile)
+	if err != nil {
+		syscall.Close(fds[1])
+		return nil, nil, err
+	}
+	return &IPCChannel{
+		conn:    conn,
+		logger:  logger,
+		actions: make(map[string]IPCAction),
+		pending: make(map[int64]chan ipc.Message),
+		metrics: make(map[string]*metric),
+	}, os.NewFile(uintptr(fds[1]), "ipc-child"), nil
+}
+
+// register the channel of a process and read its messages until it exits
+func (c *IPCChannel) start(pid int32) {
+	ipcChannelsMu.Lock()
+	ipcChannels[pid] = c
+	ipcChannelsMu.Unlock()
+
+	go func() {
+		defer func() {
+			ipcChannelsMu.Lock()
+			if ipcChannels[pid] == c {
+				delete(ipcChannels, pid)
+			}
+			ipcChannelsMu.Unlock()
+			c.close()
+		}()
+
+		scanner := bufio.NewScanner(c.conn)
+		scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			var message ipc.Message
+			if err := json.Unmarshal(scanner.Bytes(), &message); err != nil {
+				c.logger.Warn().Msgf("invalid ipc message from pid %d: %s", pid, err)
+				continue
+			}
+			c.handle(message, pid)
+		}
+	}()
+}
+
+func (c *IPCChannel) handle(message ipc.Message, pid int32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	switch message.Type {
+	case ipc.MessageAction:
+		if message.Name != "" {
+			c.actions[message.Name] = IPCAction{Name: message.Name, Description: message.Description}
+		}
+	case ipc.MessageReply:
+		if reply, ok := c.pending[message.Id]; ok {
+			delete(c.pending, message.Id)
+			reply <- message
+		}
+	case ipc.MessageMetric:
+		c.recordMetric(message, pid)
+	}
+}
+
+func (c *IPCChannel) close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	c.conn.Close()
+	for id, reply := range c.pending {
+		delete(c.pending, id)
+		close(reply)
+	}
+}
+
+// get the actions registered by the process, by name
+func (c *IPCChannel) Actions() []IPCAction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	actions := make([]IPCAction, 0, len(c.actions))
+	for _, action := range c.actions {
+		actions = append(actions, action)
+	}
+	sort.Slice(actions, func(i, j int) bool {
+		return actions[i].Name < actions[j].Name
+	})
+	return actions
+}
+
+// trigger an action of the process and wait for its reply
+func (c *IPCChannel) Trigger(ctx context.Context, action string, param string) (json.RawMessage, error) {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return nil, errors.New("process exited")
+	}
+	if _, ok := c.actions[action]; !ok {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("unknown action %s", action)
+	}
+	c.nextId++
+	id := c.nextId
+	reply := make(chan ipc.Message, 1)
+	c.pending[id] = reply
+	c.mu.Unlock()
+
+	if err := c.send(ipc.Message{Type: ipc.MessageTrigger, Id: id, Name: action, Param: param}); err != nil {
+		c.mu.Lock()
+		delete(c.pending, id)
+		c.mu.Unlock()
+		return nil, err
+	}
+
+	select {
+	case message, ok := <-reply:
+		if !ok {
+			return nil, errors.New("process exited before replying")
+		}
+		if message.Error != "" {
+			return nil, errors.New(message.Error)
+		}
+		return message.Data, nil
+	case <-ctx.Done():
+		c.mu.Lock()
+		delete(c.pending, id)
+		c.mu.Unlock()
+		return nil, fmt.Errorf("no reply to %s: %v", action, ctx.Err())
+	}
+}
+
+func (c *IPCChannel) send(message ipc.Message) error {
+	content, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, err = c.conn.Write(append(content, '\n'))
+	return err
+}
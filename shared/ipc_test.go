@@ -0,0 +1,73 @@
+package shared
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/dunstorm/pm2-go/ipc"
+	"github.com/rs/zerolog"
+)
+
+func TestIPCChannel(t *testing.T) {
+	logger := zerolog.Nop()
+	channel, file, err := newIPCChannel(&logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	app, err := net.FileConn(file)
+	file.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	const pid = 1 << 30
+	channel.start(pid)
+	if IPCChannelOf(pid) != channel {
+		t.Fatal("expected the channel to be registered")
+	}
+
+	// the app registers an action and echoes the param
+	app.Write([]byte(`{"type":"action","name":"echo","description":"echo the param"}` + "\n"))
+	go func() {
+		scanner := bufio.NewScanner(app)
+		for scanner.Scan() {
+			var message ipc.Message
+			json.Unmarshal(scanner.Bytes(), &message)
+			reply, _ := json.Marshal(ipc.Message{Type: ipc.MessageReply, Id: message.Id, Data: json.RawMessage(`"` + message.Param + `"`)})
+			app.Write(append(reply, '\n'))
+		}
+	}()
+
+	deadline := time.Now().Add(time.Second)
+	for len(channel.Actions()) == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if actions := channel.Actions(); len(actions) != 1 || actions[0].Name != "echo" || actions[0].Description != "echo the param" {
+		t.Fatalf("unexpected actions %v", actions)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if reply, err := channel.Trigger(ctx, "echo", "hello"); err != nil || string(reply) != `"hello"` {
+		t.Errorf("unexpected reply %s, %v", reply, err)
+	}
+	if _, err := channel.Trigger(ctx, "missing", ""); err == nil {
+		t.Error("expected an unknown action error")
+	}
+
+	// the channel is removed once the app exits
+	app.Close()
+	deadline = time.Now().Add(time.Second)
+	for IPCChannelOf(pid) != nil && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if IPCChannelOf(pid) != nil {
+		t.Error("expected the channel to be removed")
+	}
+	if _, err := channel.Trigger(ctx, "echo", ""); err == nil {
+		t.Error("expected an error once the app exited")
+	}
+}
@@ -0,0 +1,67 @@
+package shared
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var labelPattern = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9._/-]{0,61}[A-Za-z0-9])?$`)
+
+// check the keys and values of the labels of an app
+func ValidateLabels(labels map[string]string) error {
+	for key, value := range labels {
+		if !labelPattern.MatchString(key) {
+			return fmt.Errorf("invalid label key %q, expected up to 63 letters, digits, '.', '_', '/' or '-'", key)
+		}
+		if value != "" && !labelPattern.MatchString(value) {
+			return fmt.Errorf("invalid value %q of label %s, expected up to 63 letters, digits, '.', '_', '/' or '-'", value, key)
+		}
+	}
+	return nil
+}
+
+// LabelRequirement is a part of a label selector: key=value, key!=value or key
+type LabelRequirement struct {
+	Key   string
+	Value string
+	// the label must be different from the value, or missing
+	Not bool
+	// the label must only exist
+	Exists bool
+}
+
+// parse a comma separated label selector, e.g. tier=backend,env!=dev
+func ParseSelector(selector string) ([]LabelRequirement, error) {
+	var requirements []LabelRequirement
+	for _, part := range strings.Split(selector, ",") {
+		part = strings.TrimSpace(part)
+		requirement := LabelRequirement{}
+		if key, value, ok := strings.Cut(part, "!="); ok {
+			requirement = LabelRequirement{Key: key, Value: value, Not: true}
+		} else if key, value, ok := strings.Cut(part, "="); ok {
+			requirement = LabelRequirement{Key: key, Value: strings.TrimPrefix(value, "=")}
+		} else {
+			requirement = LabelRequirement{Key: part, Exists: true}
+		}
+		requirement.Key = strings.TrimSpace(requirement.Key)
+		requirement.Value = strings.TrimSpace(requirement.Value)
+		if !labelPattern.MatchString(requirement.Key) {
+			return nil, fmt.Errorf("invalid label selector %q", selector)
+		}
+		requirements = append(requirements, requirement)
+	}
+	return requirements, nil
+}
+
+// check labels match a requirement
+func (r LabelRequirement) Matches(labels map[string]string) bool {
+	value, ok := labels[r.Key]
+	switch {
+	case r.Exists:
+		return ok
+	case r.Not:
+		return !ok || value != r.Value
+	}
+	return ok && value == r.Value
+}
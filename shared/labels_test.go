@@ -0,0 +1,64 @@
+package shared
+
+import "testing"
+
+func TestParseSelector(t *testing.T) {
+	requirements, err := ParseSelector("tier=backend, env!=dev,canary,team==core")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []LabelRequirement{
+		{Key: "tier", Value: "backend"},
+		{Key: "env", Value: "dev", Not: true},
+		{Key: "canary", Exists: true},
+		{Key: "team", Value: "core"},
+	}
+	if len(requirements) != len(expected) {
+		t.Fatalf("expected %d requirements, got %+v", len(expected), requirements)
+	}
+	for i := range expected {
+		if requirements[i] != expected[i] {
+			t.Errorf("expected %+v, got %+v", expected[i], requirements[i])
+		}
+	}
+
+	for _, selector := range []string{"", "tier=backend,", "=backend", "tier backend=x"} {
+		if _, err := ParseSelector(selector); err == nil {
+			t.Errorf("expected an error for %q", selector)
+		}
+	}
+}
+
+func TestLabelRequirementMatches(t *testing.T) {
+	labels := map[string]string{"tier": "backend", "env": "prod"}
+	cases := map[string]bool{
+		"tier=backend":  true,
+		"tier=frontend": false,
+		"env!=dev":      true,
+		"env!=prod":     false,
+		"canary!=true":  true,
+		"env":           true,
+		"canary":        false,
+		"missing=":      false,
+	}
+	for selector, matches := range cases {
+		requirements, err := ParseSelector(selector)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if requirements[0].Matches(labels) != matches {
+			t.Errorf("expected %s to match %v", selector, matches)
+		}
+	}
+}
+
+func TestValidateLabels(t *testing.T) {
+	if err := ValidateLabels(map[string]string{"tier": "backend", "app.kubernetes.io/name": "api", "empty": ""}); err != nil {
+		t.Error(err)
+	}
+	for _, labels := range []map[string]string{{"": "x"}, {"tier": "back end"}, {"-tier": "x"}} {
+		if err := ValidateLabels(labels); err == nil {
+			t.Errorf("expected an error for %v", labels)
+		}
+	}
+}
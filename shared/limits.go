@@ -0,0 +1,192 @@
+package shared
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+
+	pb "github.com/dunstorm/pm2-go/proto"
+)
+
+// a process with rlimits or a umask is started through pm2-go itself, which applies
+// them then executes the process, as go can't run code between fork and exec
+const (
+	execShimEnv       = "_PM2_GO_EXEC"
+	execRlimitsEnv    = "_PM2_GO_RLIMITS"
+	execCredentialEnv = "_PM2_GO_CREDENTIAL"
+	execUmaskEnv      = "_PM2_GO_UMASK"
+
+	// setrlimit resource missing from the syscall package on linux
+	rlimitNproc = 0x6
+)
+
+// rlimit resources by name
+var rlimitResources = map[string]int{
+	"nofile": syscall.RLIMIT_NOFILE,
+	"core":   syscall.RLIMIT_CORE,
+	"nproc":  rlimitNproc,
+	"as":     syscall.RLIMIT_AS,
+}
+
+// get the rlimits which are set, by resource name
+func rlimits(limits *pb.Limits) map[string]int64 {
+	values := make(map[string]int64)
+	if limits == nil {
+		return values
+	}
+	if limits.Nofile != nil {
+		values["nofile"] = limits.GetNofile()
+	}
+	if limits.Core != nil {
+		values["core"] = limits.GetCore()
+	}
+	if limits.Nproc != nil {
+		values["nproc"] = limits.GetNproc()
+	}
+	if limits.As != nil {
+		values["as"] = limits.GetAs()
+	}
+	return values
+}
+
+// check the limits are in range
+func ValidateLimits(limits *pb.Limits) error {
+	for name, value := range rlimits(limits) {
+		if value < -1 {
+			return fmt.Errorf("invalid %s limit %d", name, value)
+		}
+	}
+	if limits.GetCpu() < 0 {
+		return fmt.Errorf("invalid cpu limit %v", limits.GetCpu())
+	}
+	if limits.GetMemory() < 0 {
+		return fmt.Errorf("invalid memory limit %d", limits.GetMemory())
+	}
+	return nil
+}
+
+// wrap cmd so that it's started by the exec shim, which applies the rlimits and the
+// umask then switches to credential. cmd keeps its pid as the shim executes the process
+// in place. the umask of the daemon is process wide, it's never changed
+func wrapWithExecShim(cmd *exec.Cmd, limits *pb.Limits, credential *syscall.Credential, umask string) error {
+	values := rlimits(limits)
+	if len(values) == 0 && umask == "" {
+		return nil
+	}
+	if umask != "" {
+		if _, err := ParseUmask(umask); err != nil {
+			return err
+		}
+	}
+	self, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	var encoded []string
+	for name, value := range values {
+		encoded = append(encoded, fmt.Sprintf("%s=%d", name, value))
+	}
+	cmd.Env = append(cmd.Env, execShimEnv+"=1", execRlimitsEnv+"="+strings.Join(encoded, ","), execUmaskEnv+"="+umask)
+
+	// the credential is switched by the shim, as only root can raise hard limits
+	if credential != nil {
+		groups := make([]string, len(credential.Groups))
+		for i, group := range credential.Groups {
+			groups[i] = strconv.FormatUint(uint64(group), 10)
+		}
+		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%d:%d:%s:%v", execCredentialEnv, credential.Uid, credential.Gid, strings.Join(groups, " "), credential.NoSetGroups))
+		cmd.SysProcAttr.Credential = nil
+	}
+
+	// the process name is kept as argv[0], the shim executes it
+	cmd.Path = self
+	return nil
+}
+
+// apply a credential encoded by wrapWithExecShim
+func switchCredential(encoded string) error {
+	parts := strings.SplitN(encoded, ":", 4)
+	if len(parts) != 4 {
+		return fmt.Errorf("invalid credential %q", encoded)
+	}
+	uid, _ := strconv.Atoi(parts[0])
+	gid, _ := strconv.Atoi(parts[1])
+	if parts[3] != "true" {
+		var groups []int
+		for _, group := range strings.Fields(parts[2]) {
+			id, _ := strconv.Atoi(group)
+			groups = append(groups, id)
+		}
+		if err := syscall.Setgroups(groups); err != nil {
+			return err
+		}
+	}
+	if err := syscall.Setgid(gid); err != nil {
+		return err
+	}
+	return syscall.Setuid(uid)
+}
+
+// apply rlimits encoded by wrapWithExecShim
+func applyRlimits(encoded string) error {
+	for _, limit := range strings.Split(encoded, ",") {
+		name, value, _ := strings.Cut(limit, "=")
+		resource, ok := rlimitResources[name]
+		if !ok {
+			return fmt.Errorf("unknown rlimit %s", name)
+		}
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid %s rlimit %s", name, value)
+		}
+		rlimit := syscall.Rlimit{Cur: uint64(n), Max: uint64(n)}
+		if n < 0 {
+			rlimit = syscall.Rlimit{Cur: math.MaxUint64, Max: math.MaxUint64}
+		}
+		if err := syscall.Setrlimit(resource, &rlimit); err != nil {
+			return fmt.Errorf("failed to set %s rlimit to %s: %v", name, value, err)
+		}
+	}
+	return nil
+}
+
+// run as the exec shim if pm2-go was started by wrapWithExecShim, it never returns then
+func RunExecShim() {
+	if os.Getenv(execShimEnv) != "1" {
+		return
+	}
+	rlimits, credential, umask := os.Getenv(execRlimitsEnv), os.Getenv(execCredentialEnv), os.Getenv(execUmaskEnv)
+	os.Unsetenv(execShimEnv)
+	os.Unsetenv(execRlimitsEnv)
+	os.Unsetenv(execCredentialEnv)
+	os.Unsetenv(execUmaskEnv)
+
+	if rlimits != "" {
+		if err := applyRlimits(rlimits); err != nil {
+			fmt.Fprintf(os.Stderr, "pm2-go: %v\n", err)
+			os.Exit(1)
+		}
+	}
+	if umask != "" {
+		mask, err := ParseUmask(umask)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "pm2-go: %v\n", err)
+			os.Exit(1)
+		}
+		syscall.Umask(mask)
+	}
+	if credential != "" {
+		if err := switchCredential(credential); err != nil {
+			fmt.Fprintf(os.Stderr, "pm2-go: failed to switch user: %v\n", err)
+			os.Exit(1)
+		}
+	}
+	err := syscall.Exec(os.Args[0], os.Args, os.Environ())
+	fmt.Fprintf(os.Stderr, "pm2-go: failed to execute %s: %v\n", os.Args[0], err)
+	os.Exit(1)
+}
@@ -0,0 +1,179 @@
+package shared
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/dunstorm/pm2-go/ipc"
+	pb "github.com/dunstorm/pm2-go/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// metrics kept per process, new ones are dropped past it
+	maxMetrics = 256
+	// observations of a histogram its quantiles are computed from
+	histogramWindow = 1024
+	// seconds the rate of a counter is computed over
+	rateWindow = 60
+)
+
+// metric aggregated by the daemon from the values sent by a process
+type metric struct {
+	kind string
+	// total of a counter or value of a gauge
+	value float64
+
+	// increase of a counter by second, over the rate window
+	rateBuckets [rateWindow]float64
+	rateSeconds [rateWindow]int64
+	createdAt   time.Time
+
+	// observations of a histogram
+	count    int64
+	sum      float64
+	min, max float64
+	samples  []float64
+	next     int
+
+	updatedAt time.Time
+}
+
+func validateMetric(message ipc.Message) error {
+	if message.Name == "" {
+		return fmt.Errorf("metric name is required")
+	}
+	switch message.Kind {
+	case ipc.MetricCounter, ipc.MetricGauge, ipc.MetricHistogram:
+	default:
+		return fmt.Errorf("invalid kind %q of metric %s", message.Kind, message.Name)
+	}
+	if math.IsNaN(message.Value) || math.IsInf(message.Value, 0) {
+		return fmt.Errorf("invalid value of metric %s", message.Name)
+	}
+	return nil
+}
+
+func (m *metric) record(value float64, now time.Time) {
+	m.updatedAt = now
+	switch m.kind {
+	case ipc.MetricCounter:
+		m.value += value
+		second := now.Unix()
+		bucket := second % rateWindow
+		if m.rateSeconds[bucket] != second {
+			m.rateSeconds[bucket] = second
+			m.rateBuckets[bucket] = 0
+		}
+		m.rateBuckets[bucket] += value
+	case ipc.MetricGauge:
+		m.value = value
+	case ipc.MetricHistogram:
+		if m.count == 0 || value < m.min {
+			m.min = value
+		}
+		if m.count == 0 || value > m.max {
+			m.max = value
+		}
+		m.count++
+		m.sum += value
+		if len(m.samples) < histogramWindow {
+			m.samples = append(m.samples, value)
+		} else {
+			m.samples[m.next] = value
+			m.next = (m.next + 1) % histogramWindow
+		}
+	}
+}
+
+// increase of a counter per second over the last rate window
+func (m *metric) rate(now time.Time) float64 {
+	second := now.Unix()
+	total := 0.0
+	for i, bucketSecond := range m.rateSeconds {
+		if bucketSecond > second-rateWindow && bucketSecond <= second {
+			total += m.rateBuckets[i]
+		}
+	}
+	// a counter younger than the rate window is averaged over its lifetime
+	window := math.Ceil(now.Sub(m.createdAt).Seconds())
+	if window < 1 {
+		window = 1
+	}
+	if window > rateWindow {
+		window = rateWindow
+	}
+	return total / window
+}
+
+// nearest rank quantile of sorted values
+func quantile(sorted []float64, q float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(q*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	return sorted[rank]
+}
+
+func (m *metric) toProto(name string, now time.Time) *pb.Metric {
+	result := &pb.Metric{
+		Name:      name,
+		Type:      m.kind,
+		Value:     m.value,
+		UpdatedAt: timestamppb.New(m.updatedAt),
+	}
+	switch m.kind {
+	case ipc.MetricCounter:
+		result.Rate = m.rate(now)
+	case ipc.MetricHistogram:
+		result.Count, result.Sum, result.Min, result.Max = m.count, m.sum, m.min, m.max
+		if m.count > 0 {
+			result.Value = m.sum / float64(m.count)
+		}
+		sorted := append([]float64(nil), m.samples...)
+		sort.Float64s(sorted)
+		result.P50 = quantile(sorted, 0.5)
+		result.P95 = quantile(sorted, 0.95)
+		result.P99 = quantile(sorted, 0.99)
+	}
+	return result
+}
+
+// record a metric sent by the process, c.mu is held
+func (c *IPCChannel) recordMetric(message ipc.Message, pid int32) {
+	if err := validateMetric(message); err != nil {
+		c.logger.Warn().Msgf("invalid metric from pid %d: %s", pid, err)
+		return
+	}
+	m, ok := c.metrics[message.Name]
+	// a metric changing kind starts over
+	if !ok || m.kind != message.Kind {
+		if !ok && len(c.metrics) >= maxMetrics {
+			c.logger.Warn().Msgf("too many metrics from pid %d, dropping %s", pid, message.Name)
+			return
+		}
+		m = &metric{kind: message.Kind, createdAt: time.Now()}
+		c.metrics[message.Name] = m
+	}
+	m.record(message.Value, time.Now())
+}
+
+// get the metrics of the process, by name
+func (c *IPCChannel) Metrics() []*pb.Metric {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	metrics := make([]*pb.Metric, 0, len(c.metrics))
+	for name, m := range c.metrics {
+		metrics = append(metrics, m.toProto(name, now))
+	}
+	sort.Slice(metrics, func(i, j int) bool {
+		return metrics[i].Name < metrics[j].Name
+	})
+	return metrics
+}
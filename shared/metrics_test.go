@@ -0,0 +1,67 @@
+package shared
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dunstorm/pm2-go/ipc"
+)
+
+func TestMetric(t *testing.T) {
+	now := time.Unix(1000, 0)
+
+	counter := &metric{kind: ipc.MetricCounter, createdAt: now.Add(-2 * time.Minute)}
+	counter.record(30, now.Add(-2*time.Minute))
+	counter.record(50, now.Add(-time.Second))
+	counter.record(10, now)
+	if m := counter.toProto("requests", now); m.Value != 90 || m.Rate != 1 {
+		t.Errorf("unexpected counter %v", m)
+	}
+	// a young counter's rate is over its lifetime
+	young := &metric{kind: ipc.MetricCounter, createdAt: now.Add(-10 * time.Second)}
+	young.record(50, now)
+	if m := young.toProto("requests", now); m.Rate != 5 {
+		t.Errorf("unexpected rate %v", m.Rate)
+	}
+
+	gauge := &metric{kind: ipc.MetricGauge}
+	gauge.record(5, now)
+	gauge.record(3, now)
+	if m := gauge.toProto("queue", now); m.Value != 3 {
+		t.Errorf("unexpected gauge %v", m)
+	}
+
+	histogram := &metric{kind: ipc.MetricHistogram}
+	for i := 1; i <= 100; i++ {
+		histogram.record(float64(i), now)
+	}
+	m := histogram.toProto("latency", now)
+	if m.Count != 100 || m.Sum != 5050 || m.Value != 50.5 || m.Min != 1 || m.Max != 100 {
+		t.Errorf("unexpected histogram %v", m)
+	}
+	if m.P50 != 50 || m.P95 != 95 || m.P99 != 99 {
+		t.Errorf("unexpected quantiles %v %v %v", m.P50, m.P95, m.P99)
+	}
+
+	// quantiles are computed over the last observations
+	for i := 0; i < histogramWindow; i++ {
+		histogram.record(1000, now)
+	}
+	if m := histogram.toProto("latency", now); m.P50 != 1000 || m.Min != 1 {
+		t.Errorf("unexpected histogram %v", m)
+	}
+}
+
+func TestValidateMetric(t *testing.T) {
+	for _, message := range []ipc.Message{
+		{Kind: ipc.MetricGauge},
+		{Name: "queue", Kind: "meter"},
+	} {
+		if err := validateMetric(message); err == nil {
+			t.Errorf("expected an error for %v", message)
+		}
+	}
+	if err := validateMetric(ipc.Message{Name: "queue", Kind: ipc.MetricGauge, Value: 1}); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+}
@@ -0,0 +1,29 @@
+package shared
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// namespace of the processes started without one
+const DefaultNamespace = "default"
+
+var namespacePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,63}$`)
+
+// a range of process ids target, e.g. 1-3
+var IdRangePattern = regexp.MustCompile(`^(\d+)-(\d+)$`)
+
+// check a namespace can be told apart from the ids, the id ranges and the all target
+func ValidateNamespace(namespace string) error {
+	if namespace == "" {
+		return nil
+	}
+	if !namespacePattern.MatchString(namespace) {
+		return fmt.Errorf("invalid namespace %q, expected up to 64 letters, digits, '.', '_' or '-'", namespace)
+	}
+	if _, err := strconv.Atoi(namespace); err == nil || IdRangePattern.MatchString(namespace) || namespace == "all" {
+		return fmt.Errorf("namespace %q is reserved", namespace)
+	}
+	return nil
+}
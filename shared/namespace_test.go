@@ -0,0 +1,16 @@
+package shared
+
+import "testing"
+
+func TestValidateNamespace(t *testing.T) {
+	for _, namespace := range []string{"", "default", "api-ns", "1-api", "team_a.prod"} {
+		if err := ValidateNamespace(namespace); err != nil {
+			t.Errorf("unexpected error for %q: %v", namespace, err)
+		}
+	}
+	for _, namespace := range []string{"all", "12", "1-3", "-api", "api ns", "api/ns"} {
+		if err := ValidateNamespace(namespace); err == nil {
+			t.Errorf("expected an error for %q", namespace)
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package shared
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"syscall"
+)
+
+// LogWriter is a log file shared by the pipelines writing into it,
+// it's closed once the last of them is done
+type LogWriter struct {
+	mu       sync.Mutex
+	file     io.WriteCloser
+	filePath string
+	refs     int
+}
+
+// log writers by file path, used to reopen files after rotation
+var (
+	logWritersMu sync.Mutex
+	logWriters   = make(map[string][]*LogWriter)
+)
+
+func NewLogWriter(file io.WriteCloser) *LogWriter {
+	return &LogWriter{file: file}
+}
+
+// create a log writer which can be reopened by its file path
+func newFileLogWriter(file *os.File) *LogWriter {
+	w := &LogWriter{file: file, filePath: file.Name()}
+	logWritersMu.Lock()
+	defer logWritersMu.Unlock()
+	logWriters[w.filePath] = append(logWriters[w.filePath], w)
+	return w
+}
+
+func unregisterLogWriter(w *LogWriter) {
+	logWritersMu.Lock()
+	defer logWritersMu.Unlock()
+	writers := logWriters[w.filePath]
+	for i, writer := range writers {
+		if writer == w {
+			writers = append(writers[:i], writers[i+1:]...)
+			break
+		}
+	}
+	if len(writers) == 0 {
+		delete(logWriters, w.filePath)
+	} else {
+		logWriters[w.filePath] = writers
+	}
+}
+
+// check if the daemon is writing filePath itself
+func HasLogWriter(filePath string) bool {
+	logWritersMu.Lock()
+	defer logWritersMu.Unlock()
+	return len(logWriters[filePath]) > 0
+}
+
+// reopen every log writer of filePath, e.g. once it has been renamed
+func ReopenLogFile(filePath string) error {
+	logWritersMu.Lock()
+	writers := append([]*LogWriter{}, logWriters[filePath]...)
+	logWritersMu.Unlock()
+	for _, w := range writers {
+		if err := w.reopen(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *LogWriter) reopen() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.refs == 0 {
+		return nil
+	}
+	file, err := openLogFile(w.filePath)
+	if err != nil {
+		return err
+	}
+	// keep the owner of the log file, the process may run as another user
+	if old, ok := w.file.(*os.File); ok {
+		if info, err := old.Stat(); err == nil {
+			if stat, ok := info.Sys().(*syscall.Stat_t); ok {
+				file.Chown(int(stat.Uid), int(stat.Gid))
+			}
+		}
+	}
+	w.file.Close()
+	w.file = file
+	return nil
+}
+
+func (w *LogWriter) acquire() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.refs++
+}
+
+func (w *LogWriter) release() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.refs--
+	if w.refs == 0 {
+		w.file.Close()
+		if w.filePath != "" {
+			unregisterLogWriter(w)
+		}
+	}
+}
+
+// write a line at once, so that lines of several streams don't get mixed
+func (w *LogWriter) WriteLine(line string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	_, err := io.WriteString(w.file, line+"\n")
+	return err
+}
+
+// LogPipeline copies an output stream of a process into its log files,
+// passing every line through a chain of transformers
+type LogPipeline struct {
+	transformers []LogTransformer
+	outs         []*LogWriter
+	done         chan struct{}
+}
+
+func NewLogPipeline(transformers []LogTransformer, outs ...*LogWriter) *LogPipeline {
+	for _, out := range outs {
+		out.acquire()
+	}
+	return &LogPipeline{
+		transformers: transformers,
+		outs:         outs,
+		done:         make(chan struct{}),
+	}
+}
+
+// run transformer on each line received from in
+func runTransformer(transformer LogTransformer, in <-chan string, out chan<- string) {
+	if streamer, ok := transformer.(streamTransformer); ok {
+		streamer.Stream(in, out)
+		return
+	}
+	defer close(out)
+	for line := range in {
+		for _, transformed := range transformer.Transform(line) {
+			out <- transformed
+		}
+	}
+	for _, transformed := range transformer.Flush() {
+		out <- transformed
+	}
+}
+
+// start reading from r, the pipeline stops once r reaches EOF
+func (p *LogPipeline) Start(r io.ReadCloser) {
+	lines := make(chan string, 64)
+	go func() {
+		defer close(lines)
+		defer r.Close()
+		reader := bufio.NewReader(r)
+		for {
+			line, err := reader.ReadString('\n')
+			if len(line) > 0 {
+				lines <- strings.TrimSuffix(line, "\n")
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	var in <-chan string = lines
+	for _, transformer := range p.transformers {
+		out := make(chan string, 64)
+		go runTransformer(transformer, in, out)
+		in = out
+	}
+
+	go func() {
+		defer close(p.done)
+		for line := range in {
+			for _, out := range p.outs {
+				out.WriteLine(line)
+			}
+		}
+		for _, out := range p.outs {
+			out.release()
+		}
+	}()
+}
+
+// wait for the pipeline to write its last line
+func (p *LogPipeline) Wait() {
+	<-p.done
+}
+
+// open a log file for appending
+func openLogFile(filePath string) (*os.File, error) {
+	return os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0640)
+}
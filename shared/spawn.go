@@ -3,12 +3,16 @@ package shared
 import (
 	"errors"
 	"fmt"
+	"io"
+	"maps"
 	"os"
 	"os/exec"
 	"path"
+	"slices"
 	"strings"
 	"syscall"
 
+	"github.com/dunstorm/pm2-go/ipc"
 	pb "github.com/dunstorm/pm2-go/proto"
 	"github.com/dunstorm/pm2-go/utils"
 	"github.com/rs/zerolog"
@@ -24,13 +28,77 @@ type SpawnParams struct {
 	CronRestart    string   `json:"cron_restart"`
 	Logger         *zerolog.Logger
 
-	PidPilePath string `json:"-"`
-	LogFilePath string `json:"-"`
-	ErrFilePath string `json:"-"`
+	LogTransformers []*pb.LogTransformerSpec `json:"log_transformers"`
+	OutFile         string                   `json:"out_file"`
+	ErrorFile       string                   `json:"error_file"`
+	LogFile         string                   `json:"log_file"`
+	MergeLogs       bool                     `json:"merge_logs"`
+	LogRotate       *pb.LogRotate            `json:"logrotate"`
+	HealthCheck     *pb.HealthCheck          `json:"health_check"`
+	DependsOn       []string                 `json:"depends_on"`
+	Hooks           *pb.Hooks                `json:"hooks"`
+	User            string                   `json:"user"`
+	Group           string                   `json:"group"`
+	Umask           string                   `json:"umask"`
+	Limits          *pb.Limits               `json:"limits"`
+	TreeKill        *bool                    `json:"treekill"`
+	Namespace       string                   `json:"namespace"`
+	Labels          map[string]string        `json:"labels"`
+	Env             map[string]string        `json:"env"`
 
-	logFile  *os.File
-	errFile  *os.File
-	nullFile *os.File
+	// the daemon writes the log files itself, so that they can be reopened
+	PipeLogs bool `json:"-"`
+	// id the daemon gives the process, its cgroup is named after it
+	Id int32 `json:"-"`
+
+	PidPilePath      string `json:"-"`
+	LogFilePath      string `json:"-"`
+	ErrFilePath      string `json:"-"`
+	CombinedFilePath string `json:"-"`
+
+	logFile      *os.File
+	errFile      *os.File
+	combinedFile *os.File
+	nullFile     *os.File
+}
+
+// create spawn params from a spawn request
+func NewSpawnParams(request *pb.SpawnProcessRequest, logger *zerolog.Logger) SpawnParams {
+	return SpawnParams{
+		Name:            request.Name,
+		Args:            request.Args,
+		ExecutablePath:  request.ExecutablePath,
+		AutoRestart:     request.AutoRestart,
+		Logger:          logger,
+		Cwd:             request.Cwd,
+		Scripts:         request.Scripts,
+		CronRestart:     request.CronRestart,
+		LogTransformers: request.LogTransformers,
+		OutFile:         request.OutFile,
+		ErrorFile:       request.ErrorFile,
+		LogFile:         request.LogFile,
+		MergeLogs:       request.MergeLogs,
+		LogRotate:       request.LogRotate,
+		HealthCheck:     request.HealthCheck,
+		DependsOn:       request.DependsOn,
+		Hooks:           request.Hooks,
+		User:            request.User,
+		Group:           request.Group,
+		Umask:           request.Umask,
+		Limits:          request.Limits,
+		TreeKill:        request.TreeKill,
+		Namespace:       request.Namespace,
+		Labels:          request.Labels,
+		Env:             request.Env,
+	}
+}
+
+// log paths are relative to the working directory of the process
+func (params *SpawnParams) resolvePath(filePath string) string {
+	if filePath == "" || path.IsAbs(filePath) {
+		return filePath
+	}
+	return path.Join(params.Cwd, filePath)
 }
 
 func (params *SpawnParams) fillDefaults() error {
@@ -50,152 +118,253 @@ func (params *SpawnParams) fillDefaults() error {
 		params.Cwd, _ = os.Getwd()
 	}
 
+	if params.Namespace == "" {
+		params.Namespace = DefaultNamespace
+	}
+
+	for key := range params.Env {
+		if key == "" || strings.ContainsAny(key, "=\x00") {
+			return fmt.Errorf("invalid environment variable %q", key)
+		}
+	}
+
 	nameLower := strings.ToLower(params.Name)
 	params.PidPilePath = path.Join(utils.GetMainDirectory(), "pids", fmt.Sprintf("%s.pid", nameLower))
-	params.LogFilePath = path.Join(utils.GetMainDirectory(), "logs", fmt.Sprintf("%s-out.log", nameLower))
-	params.ErrFilePath = path.Join(utils.GetMainDirectory(), "logs", fmt.Sprintf("%s-err.log", nameLower))
+	params.LogFilePath, params.ErrFilePath, params.CombinedFilePath = params.logFilePaths(utils.GetMainDirectory())
 
 	return nil
 }
 
+// the stdout, stderr and combined log files, the default ones are in the logs of
+// mainDirectory
+func (params *SpawnParams) logFilePaths(mainDirectory string) (string, string, string) {
+	nameLower := strings.ToLower(params.Name)
+	outFile := path.Join(mainDirectory, "logs", fmt.Sprintf("%s-out.log", nameLower))
+	errFile := path.Join(mainDirectory, "logs", fmt.Sprintf("%s-err.log", nameLower))
+	if params.OutFile != "" {
+		outFile = params.resolvePath(params.OutFile)
+	}
+	if params.ErrorFile != "" {
+		errFile = params.resolvePath(params.ErrorFile)
+	}
+	if params.MergeLogs {
+		errFile = outFile
+	}
+	return outFile, errFile, params.resolvePath(params.LogFile)
+}
+
+// the stdout, stderr and combined log files of a request spawned by the daemon of
+// mainDirectory, the request has a name and an absolute cwd
+func LogFilePaths(request *pb.SpawnProcessRequest, mainDirectory string) (string, string, string) {
+	params := NewSpawnParams(request, nil)
+	return params.logFilePaths(mainDirectory)
+}
+
 func (params *SpawnParams) createFiles() error {
 	var err error
-	if params.logFile, err = os.OpenFile(params.LogFilePath, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0640); err != nil {
+	if params.logFile, err = openLogFile(params.LogFilePath); err != nil {
 		return err
 	}
-	if params.errFile, err = os.OpenFile(params.ErrFilePath, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0640); err != nil {
+	if params.ErrFilePath == params.LogFilePath {
+		params.errFile = params.logFile
+	} else if params.errFile, err = openLogFile(params.ErrFilePath); err != nil {
+		params.closeFiles()
 		return err
 	}
+	if params.CombinedFilePath != "" {
+		if params.combinedFile, err = openLogFile(params.CombinedFilePath); err != nil {
+			params.closeFiles()
+			return err
+		}
+	}
 	if params.nullFile, err = os.Open(os.DevNull); err != nil {
+		params.closeFiles()
 		return err
 	}
 	return nil
 }
 
-func (params *SpawnParams) checkScripts() {
-	for _, script := range params.Scripts {
-		scriptPath := path.Join(utils.GetMainDirectory(), "scripts", script+".sh")
-		if _, err := os.Stat(scriptPath); os.IsNotExist(err) {
-			params.Logger.Fatal().Msgf("Script %s not found in path %s", script, scriptPath)
+// close the log files, with merge_logs stdout and stderr share one
+func (params *SpawnParams) closeFiles() {
+	closed := make(map[*os.File]bool)
+	for _, file := range []*os.File{params.logFile, params.errFile, params.combinedFile} {
+		if file != nil && !closed[file] {
+			closed[file] = true
+			file.Close()
 		}
 	}
 }
 
-func createPipedProcesses(params *SpawnParams, stdoutLogsRead *os.File, stderrLogsRead *os.File, stdoutLogsWrite *os.File, stderrLogsWrite *os.File) error {
-	var err error
-	var newStdoutLogsRead, newErrorLogsRead *os.File
-	for index, script := range params.Scripts {
-		scriptPath := path.Join(utils.GetMainDirectory(), "scripts", script+".sh")
-		if index == len(params.Scripts)-1 {
-			stdoutLogsWrite = params.logFile
-			stderrLogsWrite = params.errFile
-		} else {
-			newStdoutLogsRead, stdoutLogsWrite, err = os.Pipe()
-			if err != nil {
-				params.Logger.Fatal().Msg(err.Error())
-				return err
-			}
-			newErrorLogsRead, stderrLogsWrite, err = os.Pipe()
-			if err != nil {
-				params.Logger.Fatal().Msg(err.Error())
-				return err
-			}
-		}
-		for _, stream := range []struct {
-			read, write *os.File
-		}{
-			{stdoutLogsRead, stdoutLogsWrite},
-			{stderrLogsRead, stderrLogsWrite},
-		} {
-			cmd := exec.Command("/bin/sh", scriptPath)
-			cmd.Dir = params.Cwd
-			cmd.Env = os.Environ()
-			cmd.Stdin = stream.read
-			cmd.Stdout = stream.write
-			cmd.Stderr = params.nullFile
-			if err := cmd.Start(); err != nil {
-				params.Logger.Fatal().Msg(err.Error())
-				return err
-			}
+// pipe the output streams into the log files through the transformers
+func (params *SpawnParams) startPipelines(stdout, stderr io.ReadCloser, stdoutTransformers, stderrTransformers []LogTransformer) {
+	outWriter := newFileLogWriter(params.logFile)
+	errWriter := outWriter
+	if params.errFile != params.logFile {
+		errWriter = newFileLogWriter(params.errFile)
+	}
+	stdoutWriters := []*LogWriter{outWriter}
+	stderrWriters := []*LogWriter{errWriter}
+	if params.combinedFile != nil {
+		combinedWriter := newFileLogWriter(params.combinedFile)
+		stdoutWriters = append(stdoutWriters, combinedWriter)
+		stderrWriters = append(stderrWriters, combinedWriter)
+	}
+
+	// pipelines close the log files once the process exits
+	NewLogPipeline(stdoutTransformers, stdoutWriters...).Start(stdout)
+	NewLogPipeline(stderrTransformers, stderrWriters...).Start(stderr)
+}
+
+// build the log transformers of a stream, legacy scripts come first
+func (params *SpawnParams) buildTransformers() ([]LogTransformer, error) {
+	var transformers []LogTransformer
+	for _, script := range params.Scripts {
+		transformer, err := NewLogTransformer(&pb.LogTransformerSpec{Type: "script", Script: script}, params.Cwd, params.Logger)
+		if err != nil {
+			return nil, err
 		}
-		if newStdoutLogsRead != nil {
-			stdoutLogsRead = newStdoutLogsRead
-			stderrLogsRead = newErrorLogsRead
+		transformers = append(transformers, transformer)
+	}
+	for _, spec := range params.LogTransformers {
+		transformer, err := NewLogTransformer(spec, params.Cwd, params.Logger)
+		if err != nil {
+			return nil, err
 		}
+		transformers = append(transformers, transformer)
 	}
-	return nil
+	return transformers, nil
 }
 
+// spawn a process, its pre_start hook is run by the caller beforehand
 func SpawnNewProcess(params SpawnParams) (*pb.Process, error) {
 	if err := params.fillDefaults(); err != nil {
 		return nil, err
 	}
 
+	credential, err := LookupCredential(params.User, params.Group)
+	if err != nil {
+		return nil, err
+	}
+
 	splitExecutablePath := strings.Split(params.ExecutablePath, "/")
 	if splitExecutablePath[len(splitExecutablePath)-1] == "python" && len(params.Args) > 0 && params.Args[0] != "-u" {
 		params.Logger.Warn().Msg("Add -u flag to prevent output buffering on python")
 	}
 
+	// build one chain of transformers per stream as they keep state
+	stdoutTransformers, err := params.buildTransformers()
+	if err != nil {
+		return nil, err
+	}
+	stderrTransformers, _ := params.buildTransformers()
+	// the combined log file is written by the daemon as well
+	piped := params.PipeLogs || len(stdoutTransformers) > 0 || params.CombinedFilePath != ""
+
 	if err := params.createFiles(); err != nil {
 		return nil, err
 	}
+	defer params.nullFile.Close()
+
+	if err := chownFiles(credential, params.LogFilePath, params.ErrFilePath, params.CombinedFilePath); err != nil {
+		params.closeFiles()
+		return nil, err
+	}
 
-	var err error
 	params.ExecutablePath, err = exec.LookPath(params.ExecutablePath)
 	if err != nil {
+		params.closeFiles()
 		return nil, err
 	}
 
-	var stdoutLogsWrite, stdoutLogsRead, stderrLogsWrite, stderrLogsRead *os.File
+	cmd := exec.Command(params.ExecutablePath, params.Args...)
+	cmd.Dir = params.Cwd
+	cmd.Env = os.Environ()
+	for _, key := range slices.Sorted(maps.Keys(params.Env)) {
+		cmd.Env = append(cmd.Env, key+"="+params.Env[key])
+	}
+	cmd.Stdin = params.nullFile
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Setpgid:    true,
+		Credential: credential,
+	}
+	if err := wrapWithExecShim(cmd, params.Limits, credential, params.Umask); err != nil {
+		params.closeFiles()
+		return nil, err
+	}
 
-	if len(params.Scripts) == 0 {
-		stdoutLogsWrite = params.logFile
-		stderrLogsWrite = params.errFile
-	} else {
-		stdoutLogsRead, stdoutLogsWrite, err = os.Pipe()
-		if err != nil {
-			return nil, err
-		}
-		stderrLogsRead, stderrLogsWrite, err = os.Pipe()
+	// cgroup limits are applied when cgroup v2 can be managed by the daemon
+	cgroupPath := ""
+	if hasCgroupLimits(params.Limits) {
+		cgroup, err := openCgroup(params.Id, params.Limits)
 		if err != nil {
-			return nil, err
+			params.Logger.Warn().Msgf("cgroup limits of %s aren't applied: %s", params.Name, err)
+		} else {
+			defer cgroup.Close()
+			cmd.SysProcAttr.UseCgroupFD = true
+			cmd.SysProcAttr.CgroupFD = int(cgroup.Fd())
+			cgroupPath = cgroup.Name()
 		}
-		params.checkScripts()
 	}
 
-	defer func() {
-		if stdoutLogsRead != nil {
-			stdoutLogsRead.Close()
+	// the process gets its end of the ipc channel as fd 3
+	ipcChannel, ipcFile, err := newIPCChannel(params.Logger)
+	if err != nil {
+		params.closeFiles()
+		return nil, err
+	}
+	cmd.ExtraFiles = []*os.File{ipcFile}
+	cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%d", ipc.FdEnv, ipcFd))
+
+	var stdoutLogsRead, stdoutLogsWrite, stderrLogsRead, stderrLogsWrite *os.File
+	if piped {
+		if stdoutLogsRead, stdoutLogsWrite, err = os.Pipe(); err != nil {
+			ipcChannel.close()
+			ipcFile.Close()
+			params.closeFiles()
+			return nil, err
 		}
-		if stdoutLogsWrite != nil {
+		if stderrLogsRead, stderrLogsWrite, err = os.Pipe(); err != nil {
+			stdoutLogsRead.Close()
 			stdoutLogsWrite.Close()
+			ipcChannel.close()
+			ipcFile.Close()
+			params.closeFiles()
+			return nil, err
 		}
-		params.nullFile.Close()
-		params.logFile.Close()
-		params.errFile.Close()
-	}()
+		cmd.Stdout = stdoutLogsWrite
+		cmd.Stderr = stderrLogsWrite
+	} else {
+		cmd.Stdout = params.logFile
+		cmd.Stderr = params.errFile
+	}
 
-	cmd := exec.Command(params.ExecutablePath, params.Args...)
-	cmd.Dir = params.Cwd
-	cmd.Env = os.Environ()
-	cmd.Stdin = params.nullFile
-	cmd.Stdout = stdoutLogsWrite
-	cmd.Stderr = stderrLogsWrite
-	cmd.SysProcAttr = &syscall.SysProcAttr{
-		Setpgid: true,
+	err = cmd.Start()
+
+	// the child has its own copy of the write ends
+	ipcFile.Close()
+	if piped {
+		stdoutLogsWrite.Close()
+		stderrLogsWrite.Close()
 	}
 
-	if err := cmd.Start(); err != nil {
+	if err != nil {
+		ipcChannel.close()
+		if piped {
+			stdoutLogsRead.Close()
+			stderrLogsRead.Close()
+		}
+		params.closeFiles()
 		return nil, err
 	}
 
-	if len(params.Scripts) > 0 {
-		err = createPipedProcesses(&params, stdoutLogsRead, stderrLogsRead, stdoutLogsWrite, stderrLogsWrite)
-		if err != nil {
-			return nil, err
-		}
+	if piped {
+		params.startPipelines(stdoutLogsRead, stderrLogsRead, stdoutTransformers, stderrTransformers)
+	} else {
+		params.closeFiles()
 	}
 
+	ipcChannel.start(int32(cmd.Process.Pid))
+
 	params.Logger.Info().Msgf("[%s] ✓", params.Name)
 
 	if err := utils.WritePidToFile(params.PidPilePath, cmd.Process.Pid); err != nil {
@@ -203,6 +372,18 @@ func SpawnNewProcess(params SpawnParams) (*pb.Process, error) {
 		cmd.Process.Kill()
 		return nil, err
 	}
+	if err := chownFiles(credential, params.PidPilePath); err != nil {
+		params.Logger.Warn().Msgf("failed to chown pid file of %s: %s", params.Name, err)
+	}
+
+	// post_start runs in the background, a failure is only logged
+	if params.Hooks.GetPostStart() != "" {
+		go func(params SpawnParams) {
+			if err := RunHook(params.Hooks, HookPostStart, params.Name, params.Cwd, credential, params.Logger); err != nil {
+				params.Logger.Error().Msg(err.Error())
+			}
+		}(params)
+	}
 
 	rpcProcess := &pb.Process{
 		Name:           params.Name,
@@ -216,6 +397,23 @@ func SpawnNewProcess(params SpawnParams) (*pb.Process, error) {
 		PidFilePath:    params.PidPilePath,
 		AutoRestart:    params.AutoRestart,
 		CronRestart:    params.CronRestart,
+
+		LogTransformers:  params.LogTransformers,
+		CombinedFilePath: params.CombinedFilePath,
+		MergeLogs:        params.MergeLogs,
+		LogRotate:        params.LogRotate,
+		HealthCheck:      params.HealthCheck,
+		DependsOn:        params.DependsOn,
+		Hooks:            params.Hooks,
+		User:             params.User,
+		Group:            params.Group,
+		Umask:            params.Umask,
+		Limits:           params.Limits,
+		Cgroup:           cgroupPath,
+		TreeKill:         params.TreeKill,
+		Namespace:        params.Namespace,
+		Labels:           params.Labels,
+		Env:              params.Env,
 	}
 
 	return rpcProcess, nil
@@ -0,0 +1,274 @@
+package shared
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"regexp"
+	"time"
+
+	pb "github.com/dunstorm/pm2-go/proto"
+	"github.com/dunstorm/pm2-go/utils"
+	"github.com/rs/zerolog"
+)
+
+// LogTransformer rewrites the log lines of a process before they reach the log file
+type LogTransformer interface {
+	// Transform receives a single line (without the trailing newline) and
+	// returns the lines to hand over to the next transformer, if any
+	Transform(line string) []string
+	// Flush returns the lines still buffered once the stream is closed
+	Flush() []string
+}
+
+// streamTransformer is implemented by transformers which can't work line by line,
+// e.g. external scripts writing their output whenever they want
+type streamTransformer interface {
+	Stream(in <-chan string, out chan<- string)
+}
+
+const (
+	DefaultTimestampFormat   = "2006-01-02 15:04:05"
+	DefaultRedactReplacement = "[REDACTED]"
+	DefaultMultilinePattern  = `^\s`
+)
+
+// prefix each line with the current date & time
+type timestampTransformer struct {
+	format string
+}
+
+func (t *timestampTransformer) Transform(line string) []string {
+	return []string{fmt.Sprintf("%s: %s", time.Now().Format(t.format), line)}
+}
+
+func (t *timestampTransformer) Flush() []string {
+	return nil
+}
+
+// prefix each line with its number
+type lineNumbersTransformer struct {
+	count int
+}
+
+func (t *lineNumbersTransformer) Transform(line string) []string {
+	line = fmt.Sprintf("%d - %s", t.count, line)
+	t.count++
+	return []string{line}
+}
+
+func (t *lineNumbersTransformer) Flush() []string {
+	return nil
+}
+
+// replace every match of pattern
+type redactTransformer struct {
+	pattern     *regexp.Regexp
+	replacement string
+}
+
+func (t *redactTransformer) Transform(line string) []string {
+	return []string{t.pattern.ReplaceAllString(line, t.replacement)}
+}
+
+func (t *redactTransformer) Flush() []string {
+	return nil
+}
+
+// drop lines matching pattern
+type dropTransformer struct {
+	pattern *regexp.Regexp
+}
+
+func (t *dropTransformer) Transform(line string) []string {
+	if t.pattern.MatchString(line) {
+		return nil
+	}
+	return []string{line}
+}
+
+func (t *dropTransformer) Flush() []string {
+	return nil
+}
+
+// join continuation lines (e.g. stack traces) to the line before them
+type multilineTransformer struct {
+	pattern   *regexp.Regexp
+	separator string
+	buffer    *string
+}
+
+func (t *multilineTransformer) Transform(line string) []string {
+	if t.buffer != nil && t.pattern.MatchString(line) {
+		joined := *t.buffer + t.separator + line
+		t.buffer = &joined
+		return nil
+	}
+	lines := t.Flush()
+	t.buffer = &line
+	return lines
+}
+
+func (t *multilineTransformer) Flush() []string {
+	if t.buffer == nil {
+		return nil
+	}
+	line := *t.buffer
+	t.buffer = nil
+	return []string{line}
+}
+
+// pipe lines through a shell script placed in $HOME/.pm2-go/scripts
+type scriptTransformer struct {
+	name   string
+	path   string
+	cwd    string
+	logger *zerolog.Logger
+}
+
+func (t *scriptTransformer) Transform(line string) []string {
+	return []string{line}
+}
+
+func (t *scriptTransformer) Flush() []string {
+	return nil
+}
+
+// start the script, its output is forwarded to out until it exits
+func (t *scriptTransformer) start(out chan<- string) (io.WriteCloser, chan struct{}, error) {
+	cmd := exec.Command("/bin/sh", t.path)
+	cmd.Dir = t.cwd
+	cmd.Env = os.Environ()
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, nil, err
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		scanner := bufio.NewScanner(stdout)
+		for scanner.Scan() {
+			out <- scanner.Text()
+		}
+		cmd.Wait()
+	}()
+	return stdin, done, nil
+}
+
+func (t *scriptTransformer) Stream(in <-chan string, out chan<- string) {
+	defer close(out)
+
+	var pending *string
+	for {
+		stdin, done, err := t.start(out)
+		if err != nil {
+			t.logger.Error().Msgf("Failed to start script %s: %s", t.name, err)
+			// keep the logs flowing untouched
+			if pending != nil {
+				out <- *pending
+			}
+			for line := range in {
+				out <- line
+			}
+			return
+		}
+
+		exited := false
+		if pending != nil {
+			if _, err := io.WriteString(stdin, *pending+"\n"); err != nil {
+				exited = true
+			}
+		}
+		pending = nil
+		for !exited {
+			line, ok := <-in
+			if !ok {
+				break
+			}
+			if _, err := io.WriteString(stdin, line+"\n"); err != nil {
+				pending = &line
+				exited = true
+			}
+		}
+		stdin.Close()
+		<-done
+
+		if !exited {
+			return
+		}
+		t.logger.Warn().Msgf("Script %s exited, restarting it", t.name)
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+// get script path from its name
+func ScriptPath(name string) string {
+	return path.Join(utils.GetMainDirectory(), "scripts", name+".sh")
+}
+
+// create a transformer from its spec
+func NewLogTransformer(spec *pb.LogTransformerSpec, cwd string, logger *zerolog.Logger) (LogTransformer, error) {
+	switch spec.Type {
+	case "timestamp":
+		format := spec.Format
+		if format == "" {
+			format = DefaultTimestampFormat
+		}
+		return &timestampTransformer{format: format}, nil
+	case "line_numbers":
+		return &lineNumbersTransformer{}, nil
+	case "redact":
+		if spec.Pattern == "" {
+			return nil, fmt.Errorf("redact transformer requires a pattern")
+		}
+		pattern, err := regexp.Compile(spec.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid redact pattern: %v", err)
+		}
+		replacement := spec.Replacement
+		if replacement == "" {
+			replacement = DefaultRedactReplacement
+		}
+		return &redactTransformer{pattern: pattern, replacement: replacement}, nil
+	case "drop":
+		if spec.Pattern == "" {
+			return nil, fmt.Errorf("drop transformer requires a pattern")
+		}
+		pattern, err := regexp.Compile(spec.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid drop pattern: %v", err)
+		}
+		return &dropTransformer{pattern: pattern}, nil
+	case "multiline":
+		expr := spec.Pattern
+		if expr == "" {
+			expr = DefaultMultilinePattern
+		}
+		pattern, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid multiline pattern: %v", err)
+		}
+		separator := spec.Separator
+		if separator == "" {
+			separator = "\n"
+		}
+		return &multilineTransformer{pattern: pattern, separator: separator}, nil
+	case "script":
+		scriptPath := ScriptPath(spec.Script)
+		if _, err := os.Stat(scriptPath); os.IsNotExist(err) {
+			return nil, fmt.Errorf("script %s not found in path %s", spec.Script, scriptPath)
+		}
+		return &scriptTransformer{name: spec.Script, path: scriptPath, cwd: cwd, logger: logger}, nil
+	}
+	return nil, fmt.Errorf("unknown log transformer type %q", spec.Type)
+}
@@ -0,0 +1,70 @@
+package shared
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	pb "github.com/dunstorm/pm2-go/proto"
+)
+
+type bufferCloser struct {
+	bytes.Buffer
+}
+
+func (b *bufferCloser) Close() error {
+	return nil
+}
+
+func runPipeline(t *testing.T, input string, specs ...*pb.LogTransformerSpec) string {
+	var transformers []LogTransformer
+	for _, spec := range specs {
+		transformer, err := NewLogTransformer(spec, "", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		transformers = append(transformers, transformer)
+	}
+	out := &bufferCloser{}
+	pipeline := NewLogPipeline(transformers, NewLogWriter(out))
+	pipeline.Start(io.NopCloser(strings.NewReader(input)))
+	pipeline.Wait()
+	return out.String()
+}
+
+func TestLogPipeline(t *testing.T) {
+	output := runPipeline(t, "first\nsecret=abc\nthird",
+		&pb.LogTransformerSpec{Type: "redact", Pattern: `secret=\w+`},
+		&pb.LogTransformerSpec{Type: "line_numbers"},
+	)
+	expected := "0 - first\n1 - [REDACTED]\n2 - third\n"
+	if output != expected {
+		t.Errorf("expected %q, got %q", expected, output)
+	}
+}
+
+func TestLogPipelineDropMultiline(t *testing.T) {
+	output := runPipeline(t, "debug: noise\nTraceback:\n  line 1\n  line 2\ndone\n",
+		&pb.LogTransformerSpec{Type: "drop", Pattern: `^debug:`},
+		&pb.LogTransformerSpec{Type: "multiline", Separator: " | "},
+	)
+	expected := "Traceback: |   line 1 |   line 2\ndone\n"
+	if output != expected {
+		t.Errorf("expected %q, got %q", expected, output)
+	}
+}
+
+func TestInvalidLogTransformer(t *testing.T) {
+	specs := []*pb.LogTransformerSpec{
+		{Type: "unknown"},
+		{Type: "redact"},
+		{Type: "drop", Pattern: "("},
+		{Type: "script", Script: "does-not-exist"},
+	}
+	for _, spec := range specs {
+		if _, err := NewLogTransformer(spec, "", nil); err == nil {
+			t.Errorf("expected an error for %v", spec)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path"
+	"sort"
+	"strings"
+)
+
+// port of the remote grpc api when a host doesn't give one
+const DefaultRemotePort = "50052"
+
+// ClientContext is a daemon the cli can connect to instead of the local one
+type ClientContext struct {
+	Host string `json:"host"`
+	// the token, or a file containing it
+	Token     string `json:"token,omitempty"`
+	TokenFile string `json:"token_file,omitempty"`
+	// CA verifying the daemon, the system ones by default
+	TLSCA string `json:"tls_ca,omitempty"`
+	// client certificate, for daemons requiring one
+	TLSCert string `json:"tls_cert,omitempty"`
+	TLSKey  string `json:"tls_key,omitempty"`
+	// connect without tls, e.g. to a local daemon or through a tunnel
+	Insecure bool `json:"insecure,omitempty"`
+}
+
+// ClientConfig holds the named contexts of the cli
+type ClientConfig struct {
+	Contexts map[string]ClientContext `json:"contexts"`
+}
+
+// get the path of the client config
+func ClientConfigFilePath() string {
+	return path.Join(GetMainDirectory(), "client.json")
+}
+
+// load the client config, there are no contexts without it
+func LoadClientConfig() (ClientConfig, error) {
+	config := ClientConfig{}
+	filename := ClientConfigFilePath()
+	if err := LoadObject(filename, &config); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return config, fmt.Errorf("invalid client config %s: %s", filename, err)
+	}
+	return config, nil
+}
+
+// find a context by name
+func (c ClientConfig) Context(name string) (ClientContext, error) {
+	context, ok := c.Contexts[name]
+	if !ok {
+		names := make([]string, 0, len(c.Contexts))
+		for name := range c.Contexts {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		if len(names) == 0 {
+			return context, fmt.Errorf("unknown context %q, no contexts are defined in %s", name, ClientConfigFilePath())
+		}
+		return context, fmt.Errorf("unknown context %q, available contexts: %s", name, strings.Join(names, ", "))
+	}
+	if context.Host == "" {
+		return context, fmt.Errorf("context %q has no host", name)
+	}
+	return context, nil
+}
+
+// host:port of the daemon
+func (c ClientContext) Address() string {
+	if _, _, err := net.SplitHostPort(c.Host); err == nil {
+		return c.Host
+	}
+	return net.JoinHostPort(strings.Trim(c.Host, "[]"), DefaultRemotePort)
+}
+
+// the token of the context, if any
+func (c ClientContext) ReadToken() (string, error) {
+	if c.Token != "" || c.TokenFile == "" {
+		return c.Token, nil
+	}
+	return ReadToken(c.TokenFile)
+}
@@ -0,0 +1,39 @@
+package utils
+
+import "testing"
+
+func TestClientContextAddress(t *testing.T) {
+	for host, expected := range map[string]string{
+		"build-02":        "build-02:50052",
+		"build-02:6000":   "build-02:6000",
+		"10.0.0.2":        "10.0.0.2:50052",
+		"::1":             "[::1]:50052",
+		"[::1]":           "[::1]:50052",
+		"[::1]:6000":      "[::1]:6000",
+		"127.0.0.1:50051": "127.0.0.1:50051",
+	} {
+		if address := (ClientContext{Host: host}).Address(); address != expected {
+			t.Errorf("expected %s for %s, got %s", expected, host, address)
+		}
+	}
+}
+
+func TestClientConfigContext(t *testing.T) {
+	config := ClientConfig{Contexts: map[string]ClientContext{
+		"staging": {Host: "staging", Token: "secret"},
+		"empty":   {},
+	}}
+	context, err := config.Context("staging")
+	if err != nil || context.Host != "staging" {
+		t.Fatalf("unexpected context %+v, %v", context, err)
+	}
+	if token, err := context.ReadToken(); err != nil || token != "secret" {
+		t.Errorf("unexpected token %q, %v", token, err)
+	}
+	if _, err := config.Context("empty"); err == nil {
+		t.Error("expected an error for a context without host")
+	}
+	if _, err := config.Context("production"); err == nil {
+		t.Error("expected an error for an unknown context")
+	}
+}
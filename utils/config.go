@@ -1,43 +1,357 @@
 package utils
 
-import "os"
+import (
+	"fmt"
+	"net"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aptible/supercronic/cronexpr"
+)
 
 type Config struct {
-	LogRotate         bool `json:"logrotate"`
-	LogRotateSize     int  `json:"logrotate_size"`
-	LogRotateMaxFiles int  `json:"logrotate_max_files"`
+	LogRotate           bool   `json:"logrotate"`
+	LogRotateSize       int    `json:"logrotate_size"`
+	LogRotateMaxFiles   int    `json:"logrotate_max_files"`
+	LogRotateInterval   string `json:"logrotate_interval"`
+	LogRotateCompress   bool   `json:"logrotate_compress"`
+	LogRotateMode       string `json:"logrotate_mode"`
+	LogRotateDateFormat string `json:"logrotate_date_format"`
+	LogRotateMaxAge     string `json:"logrotate_max_age"`
+	MetricsAddress      string `json:"metrics_address"`
+	ApiAddress          string `json:"api_address"`
+	GrpcAddress         string `json:"grpc_address"`
+	Auth                bool   `json:"auth"`
+	RemoteAddress       string `json:"remote_address"`
+	TLSCert             string `json:"tls_cert"`
+	TLSKey              string `json:"tls_key"`
+	TLSClientCA         string `json:"tls_client_ca"`
+}
+
+// ConfigKey describes a config value, how it's parsed, validated and printed
+type ConfigKey struct {
+	Name        string
+	Description string
+	Default     string
+	// parse and validate value into config
+	set func(config *Config, value string) error
+	// format the value of config
+	get func(config Config) string
+}
+
+// config keys, new settings only need to be added here and to Config
+var ConfigKeys = []ConfigKey{
+	{
+		Name:        "logrotate",
+		Description: "rotate log files of the processes",
+		Default:     "false",
+		set:         func(c *Config, v string) (err error) { c.LogRotate, err = ParseBool(v); return },
+		get:         func(c Config) string { return strconv.FormatBool(c.LogRotate) },
+	},
+	{
+		Name:        "logrotate_size",
+		Description: "rotate a log file once it's bigger than this size, e.g. 10K, 10M, 1G",
+		Default:     "10M",
+		set: func(c *Config, v string) (err error) {
+			size, err := ParseSize(v)
+			if err == nil && size <= 0 {
+				err = fmt.Errorf("size must be positive")
+			}
+			c.LogRotateSize = size
+			return
+		},
+		get: func(c Config) string { return FormatSize(c.LogRotateSize) },
+	},
+	{
+		Name:        "logrotate_max_files",
+		Description: "number of rotated files to keep, 0 keeps them all",
+		Default:     "10",
+		set: func(c *Config, v string) (err error) {
+			maxFiles, err := ParseInt(v)
+			if err == nil && maxFiles < 0 {
+				err = fmt.Errorf("%d is negative", maxFiles)
+			}
+			c.LogRotateMaxFiles = maxFiles
+			return
+		},
+		get: func(c Config) string { return strconv.Itoa(c.LogRotateMaxFiles) },
+	},
+	{
+		Name:        "logrotate_interval",
+		Description: "cron expression to rotate log files on, e.g. \"0 0 * * *\"",
+		Default:     "",
+		set: func(c *Config, v string) error {
+			if v != "" {
+				if _, err := cronexpr.Parse(v); err != nil {
+					return err
+				}
+			}
+			c.LogRotateInterval = v
+			return nil
+		},
+		get: func(c Config) string { return c.LogRotateInterval },
+	},
+	{
+		Name:        "logrotate_compress",
+		Description: "compress rotated files with gzip",
+		Default:     "false",
+		set:         func(c *Config, v string) (err error) { c.LogRotateCompress, err = ParseBool(v); return },
+		get:         func(c Config) string { return strconv.FormatBool(c.LogRotateCompress) },
+	},
+	{
+		Name:        "logrotate_mode",
+		Description: "copytruncate copies then truncates log files, reopen renames them and opens new ones",
+		Default:     LogRotateModeCopyTruncate,
+		set: func(c *Config, v string) error {
+			if v != LogRotateModeCopyTruncate && v != LogRotateModeReopen {
+				return fmt.Errorf("expected %s or %s", LogRotateModeCopyTruncate, LogRotateModeReopen)
+			}
+			c.LogRotateMode = v
+			return nil
+		},
+		get: func(c Config) string { return c.LogRotateMode },
+	},
+	{
+		Name:        "logrotate_date_format",
+		Description: "Go time layout of the date stamp of rotated files",
+		Default:     DefaultLogRotateDateFormat,
+		set: func(c *Config, v string) error {
+			if v == "" {
+				return fmt.Errorf("date format can't be empty")
+			}
+			c.LogRotateDateFormat = v
+			return nil
+		},
+		get: func(c Config) string { return c.LogRotateDateFormat },
+	},
+	{
+		Name:        "logrotate_max_age",
+		Description: "delete rotated files older than this duration, e.g. 12h, 7d",
+		Default:     "",
+		set: func(c *Config, v string) error {
+			if v != "" {
+				if _, err := ParseDuration(v); err != nil {
+					return err
+				}
+			}
+			c.LogRotateMaxAge = v
+			return nil
+		},
+		get: func(c Config) string { return c.LogRotateMaxAge },
+	},
+	{
+		Name:        "metrics_address",
+		Description: "serve prometheus metrics on this address, e.g. 127.0.0.1:9615, empty disables it",
+		Default:     "",
+		set: func(c *Config, v string) error {
+			if err := validateAddress(v); err != nil {
+				return err
+			}
+			c.MetricsAddress = v
+			return nil
+		},
+		get: func(c Config) string { return c.MetricsAddress },
+	},
+	{
+		Name:        "api_address",
+		Description: "serve the http api on this address, e.g. 127.0.0.1:9616, empty disables it",
+		Default:     "",
+		set: func(c *Config, v string) error {
+			if err := validateAddress(v); err != nil {
+				return err
+			}
+			c.ApiAddress = v
+			return nil
+		},
+		get: func(c Config) string { return c.ApiAddress },
+	},
+	{
+		Name:        "grpc_address",
+		Description: "loopback address of the local grpc api, applied once the daemon is restarted",
+		Default:     "127.0.0.1:50051",
+		set: func(c *Config, v string) error {
+			if err := validateAddress(v); err != nil {
+				return err
+			}
+			if host, _, err := net.SplitHostPort(v); err != nil || !isLoopback(host) {
+				return fmt.Errorf("expected a loopback address, use remote_address for remote clients")
+			}
+			c.GrpcAddress = v
+			return nil
+		},
+		get: func(c Config) string { return c.GrpcAddress },
+	},
+	{
+		Name:        "auth",
+		Description: "require the token of ~/.pm2-go/token on the local grpc api, the remote one always requires it",
+		Default:     "false",
+		set:         func(c *Config, v string) (err error) { c.Auth, err = ParseBool(v); return },
+		get:         func(c Config) string { return strconv.FormatBool(c.Auth) },
+	},
+	{
+		Name:        "remote_address",
+		Description: "serve the grpc api with tls on this address for remote clients, e.g. 0.0.0.0:50052, empty disables it",
+		Default:     "",
+		set: func(c *Config, v string) error {
+			if err := validateAddress(v); err != nil {
+				return err
+			}
+			c.RemoteAddress = v
+			return nil
+		},
+		get: func(c Config) string { return c.RemoteAddress },
+	},
+	{
+		Name:        "tls_cert",
+		Description: "certificate file of the remote grpc api, in PEM",
+		Default:     "",
+		set:         func(c *Config, v string) error { c.TLSCert = v; return nil },
+		get:         func(c Config) string { return c.TLSCert },
+	},
+	{
+		Name:        "tls_key",
+		Description: "private key file of tls_cert, in PEM",
+		Default:     "",
+		set:         func(c *Config, v string) error { c.TLSKey = v; return nil },
+		get:         func(c Config) string { return c.TLSKey },
+	},
+	{
+		Name:        "tls_client_ca",
+		Description: "CA file verifying the certificates of remote clients, setting it requires them (mTLS)",
+		Default:     "",
+		set:         func(c *Config, v string) error { c.TLSClientCA = v; return nil },
+		get:         func(c Config) string { return c.TLSClientCA },
+	},
+}
+
+// check an optional host:port address
+func validateAddress(address string) error {
+	if address == "" {
+		return nil
+	}
+	_, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+		return fmt.Errorf("invalid port %q", port)
+	}
+	return nil
+}
+
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// find a config key by name
+func FindConfigKey(name string) (*ConfigKey, error) {
+	for i := range ConfigKeys {
+		if ConfigKeys[i].Name == name {
+			return &ConfigKeys[i], nil
+		}
+	}
+	names := make([]string, len(ConfigKeys))
+	for i, key := range ConfigKeys {
+		names[i] = key.Name
+	}
+	sort.Strings(names)
+	return nil, fmt.Errorf("unknown config key %q, available keys: %s", name, strings.Join(names, ", "))
+}
+
+// default config values
+func DefaultConfig() Config {
+	config := Config{}
+	for _, key := range ConfigKeys {
+		if err := key.set(&config, key.Default); err != nil {
+			panic(fmt.Sprintf("invalid default for %s: %s", key.Name, err))
+		}
+	}
+	return config
+}
+
+// get a config value by key
+func (c Config) Get(name string) (string, error) {
+	key, err := FindConfigKey(name)
+	if err != nil {
+		return "", err
+	}
+	return key.get(c), nil
+}
+
+// set a config value by key
+func (c *Config) Set(name string, value string) error {
+	key, err := FindConfigKey(name)
+	if err != nil {
+		return err
+	}
+	// the config is left untouched by an invalid value
+	config := *c
+	if err := key.set(&config, value); err != nil {
+		return fmt.Errorf("invalid value %q for %s: %s", value, name, err)
+	}
+	*c = config
+	return nil
+}
+
+// reset a config value to its default
+func (c *Config) Unset(name string) error {
+	key, err := FindConfigKey(name)
+	if err != nil {
+		return err
+	}
+	return key.set(c, key.Default)
+}
+
+// check every config value
+func (c Config) Validate() error {
+	for _, key := range ConfigKeys {
+		check := c
+		if err := check.Set(key.Name, key.get(c)); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // find or create config file
-func FindOrCreateConfigFile() string {
-	configFile := os.Getenv("HOME") + "/.pm2-go/config.json"
+func FindOrCreateConfigFile() (string, error) {
+	configFile := path.Join(GetMainDirectory(), "config.json")
 	if _, err := os.Stat(configFile); os.IsNotExist(err) {
-		err := SaveObject(configFile, Config{
-			LogRotate:         false,
-			LogRotateSize:     10485760,
-			LogRotateMaxFiles: 10,
-		})
-		if err != nil {
-			panic(err)
+		if err := SaveObject(configFile, DefaultConfig()); err != nil {
+			return "", err
 		}
 	}
-	return configFile
+	return configFile, nil
 }
 
-// get config
-func GetConfig() Config {
-	var config Config
-	err := LoadObject(FindOrCreateConfigFile(), &config)
+// load config, missing keys keep their default value
+func LoadConfig() (Config, error) {
+	config := DefaultConfig()
+	configFile, err := FindOrCreateConfigFile()
 	if err != nil {
-		panic(err)
+		return config, err
 	}
-	return config
+	if err := LoadObject(configFile, &config); err != nil {
+		return config, fmt.Errorf("invalid config file %s: %s", configFile, err)
+	}
+	if err := config.Validate(); err != nil {
+		return config, fmt.Errorf("invalid config file %s: %s", configFile, err)
+	}
+	return config, nil
 }
 
 // save config
-func SaveConfig(config Config) {
-	err := SaveObject(FindOrCreateConfigFile(), config)
+func SaveConfig(config Config) error {
+	configFile, err := FindOrCreateConfigFile()
 	if err != nil {
-		panic(err)
+		return err
 	}
+	return SaveObject(configFile, config)
 }
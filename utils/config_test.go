@@ -0,0 +1,58 @@
+package utils
+
+import "testing"
+
+func TestConfigSetUnset(t *testing.T) {
+	config := DefaultConfig()
+	if config.LogRotateSize != 10*1024*1024 || config.LogRotateMode != LogRotateModeCopyTruncate {
+		t.Fatalf("unexpected default config %+v", config)
+	}
+
+	if err := config.Set("logrotate_size", "5M"); err != nil {
+		t.Fatal(err)
+	}
+	if value, _ := config.Get("logrotate_size"); value != "5M" {
+		t.Errorf("unexpected logrotate_size %s", value)
+	}
+	if err := config.Set("logrotate_size", "1500K"); err != nil {
+		t.Fatal(err)
+	}
+	if value, _ := config.Get("logrotate_size"); value != "1500K" {
+		t.Errorf("unexpected logrotate_size %s", value)
+	}
+	if err := config.Unset("logrotate_size"); err != nil {
+		t.Fatal(err)
+	}
+	if value, _ := config.Get("logrotate_size"); value != "10M" {
+		t.Errorf("logrotate_size was not reset, got %s", value)
+	}
+}
+
+func TestConfigInvalidValues(t *testing.T) {
+	config := DefaultConfig()
+	invalid := map[string]string{
+		"logrotate":           "maybe",
+		"logrotate_size":      "10MM",
+		"logrotate_max_files": "-1",
+		"logrotate_interval":  "every day",
+		"logrotate_mode":      "move",
+		"logrotate_max_age":   "7w",
+		"auth":                "maybe",
+		"remote_address":      "50052",
+		"grpc_address":        "0.0.0.0:50051",
+		"unknown":             "1",
+	}
+	for key, value := range invalid {
+		if err := config.Set(key, value); err == nil {
+			t.Errorf("expected an error for %s=%s", key, value)
+		}
+	}
+	if config != DefaultConfig() {
+		t.Errorf("invalid values changed the config %+v", config)
+	}
+
+	config.LogRotateMode = "move"
+	if err := config.Validate(); err == nil {
+		t.Error("expected an invalid config")
+	}
+}
@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSortByDependencies(t *testing.T) {
+	dependencies := map[string][]string{
+		"api":      {"db-proxy", "cache"},
+		"worker":   {"api", "external"},
+		"db-proxy": {},
+	}
+	dependsOn := func(name string) []string { return dependencies[name] }
+
+	sorted, err := SortByDependencies([]string{"worker", "api", "cache", "db-proxy"}, dependsOn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"db-proxy", "cache", "api", "worker"}
+	if !reflect.DeepEqual(sorted, expected) {
+		t.Errorf("expected %v, got %v", expected, sorted)
+	}
+}
+
+func TestSortByDependenciesCycle(t *testing.T) {
+	dependencies := map[string][]string{
+		"a": {"b"},
+		"b": {"c"},
+		"c": {"a"},
+	}
+	_, err := SortByDependencies([]string{"a", "b", "c"}, func(name string) []string { return dependencies[name] })
+	if err == nil || err.Error() != "dependency cycle: a -> b -> c -> a" {
+		t.Errorf("unexpected error %v", err)
+	}
+}
@@ -2,6 +2,7 @@ package utils
 
 import (
 	"bufio"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -144,6 +145,65 @@ func Tail(logPrefix string, prefixColor func(a ...interface{}) string, filename
 	}
 }
 
+// call onLine for each line appended to filename until ctx is done, truncated or
+// replaced files are read again from the start
+func FollowFile(ctx context.Context, filename string, onLine func(line string)) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	// the file may be replaced once rotated
+	defer func() { f.Close() }()
+	offset, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	r := bufio.NewReader(f)
+	ticker := time.NewTicker(200 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		// lines without their newline yet are read once complete
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				if _, err := f.Seek(offset, io.SeekStart); err != nil {
+					return err
+				}
+				r.Reset(f)
+				break
+			}
+			offset += int64(len(line))
+			onLine(strings.TrimRight(line, "\r\n"))
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		current, err := f.Stat()
+		if err != nil {
+			return err
+		}
+		if info, err := os.Stat(filename); err == nil && !os.SameFile(info, current) {
+			// the file was rotated by renaming it
+			replaced, err := os.Open(filename)
+			if err != nil {
+				continue
+			}
+			f.Close()
+			f = replaced
+			offset = 0
+			r.Reset(f)
+		} else if current.Size() < offset {
+			offset = 0
+			if _, err := f.Seek(0, io.SeekStart); err != nil {
+				return err
+			}
+			r.Reset(f)
+		}
+	}
+}
+
 // get last modified time of a file
 func GetLastModified(filename string) time.Time {
 	info, err := os.Stat(filename)
@@ -219,7 +279,12 @@ func RemoveFileContents(filename string) error {
 
 // check if port is open
 func IsPortOpen(port int) bool {
-	conn, err := net.Dial("tcp", fmt.Sprintf("localhost:%d", port))
+	return IsAddressOpen(fmt.Sprintf("localhost:%d", port))
+}
+
+// check if a tcp address accepts connections
+func IsAddressOpen(address string) bool {
+	conn, err := net.Dial("tcp", address)
 	if err != nil {
 		return false
 	}
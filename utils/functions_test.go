@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestFollowFile(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "out.log")
+	if err := os.WriteFile(filename, []byte("before\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var mu sync.Mutex
+	var lines []string
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- FollowFile(ctx, filename, func(line string) {
+			mu.Lock()
+			lines = append(lines, line)
+			mu.Unlock()
+		})
+	}()
+	waitFor := func(expected []string) {
+		t.Helper()
+		deadline := time.Now().Add(2 * time.Second)
+		for time.Now().Before(deadline) {
+			mu.Lock()
+			got := append([]string(nil), lines...)
+			mu.Unlock()
+			if reflect.DeepEqual(got, expected) {
+				return
+			}
+			time.Sleep(20 * time.Millisecond)
+		}
+		t.Fatalf("expected lines %v, got %v", expected, lines)
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	file, _ := os.OpenFile(filename, os.O_APPEND|os.O_WRONLY, 0644)
+	file.WriteString("first\nsec")
+	time.Sleep(300 * time.Millisecond)
+	file.WriteString("ond\n")
+	file.Close()
+	waitFor([]string{"first", "second"})
+
+	// truncated
+	os.WriteFile(filename, []byte("truncated\n"), 0644)
+	waitFor([]string{"first", "second", "truncated"})
+
+	// renamed then created again
+	os.Rename(filename, filename+".1")
+	os.WriteFile(filename, []byte("rotated\n"), 0644)
+	waitFor([]string{"first", "second", "truncated", "rotated"})
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Error(err)
+	}
+}
@@ -1,49 +1,69 @@
 package utils
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
+	"time"
 )
 
-func ParseBool(str string) bool {
-	return str == "true"
+// true, false, yes, no, 1, 0
+func ParseBool(str string) (bool, error) {
+	switch strings.ToLower(str) {
+	case "true", "yes", "1":
+		return true, nil
+	case "false", "no", "0":
+		return false, nil
+	}
+	return false, fmt.Errorf("invalid boolean %q, expected true or false", str)
 }
 
-func ParseInt(str string) int {
+func ParseInt(str string) (int, error) {
 	i, err := strconv.Atoi(str)
 	if err != nil {
-		panic(err)
+		return 0, fmt.Errorf("invalid integer %q", str)
 	}
-	return i
+	return i, nil
 }
 
 // 10K, 10M, 1G
-func ParseSize(str string) int {
-	var size int
-	var err error
+func ParseSize(str string) (int, error) {
+	multiplier, number := 1, str
 	if strings.HasSuffix(str, "K") {
-		size, err = strconv.Atoi(strings.TrimSuffix(str, "K"))
-		if err != nil {
-			panic(err)
-		}
-		size = size * 1024
+		multiplier, number = 1024, strings.TrimSuffix(str, "K")
 	} else if strings.HasSuffix(str, "M") {
-		size, err = strconv.Atoi(strings.TrimSuffix(str, "M"))
-		if err != nil {
-			panic(err)
-		}
-		size = size * 1024 * 1024
+		multiplier, number = 1024*1024, strings.TrimSuffix(str, "M")
 	} else if strings.HasSuffix(str, "G") {
-		size, err = strconv.Atoi(strings.TrimSuffix(str, "G"))
-		if err != nil {
-			panic(err)
+		multiplier, number = 1024*1024*1024, strings.TrimSuffix(str, "G")
+	}
+	size, err := strconv.Atoi(number)
+	if err != nil {
+		return 0, fmt.Errorf("invalid size %q, expected e.g. 10K, 10M, 1G", str)
+	}
+	return size * multiplier, nil
+}
+
+// format a size the way ParseSize reads it, with the largest unit it's a multiple of
+func FormatSize(size int) string {
+	for _, unit := range []struct {
+		suffix     string
+		multiplier int
+	}{{"G", 1024 * 1024 * 1024}, {"M", 1024 * 1024}, {"K", 1024}} {
+		if size != 0 && size%unit.multiplier == 0 {
+			return strconv.Itoa(size/unit.multiplier) + unit.suffix
 		}
-		size = size * 1024 * 1024 * 1024
-	} else {
-		size, err = strconv.Atoi(str)
+	}
+	return strconv.Itoa(size)
+}
+
+// 30s, 12h, 7d
+func ParseDuration(str string) (time.Duration, error) {
+	if strings.HasSuffix(str, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(str, "d"))
 		if err != nil {
-			panic(err)
+			return 0, fmt.Errorf("invalid duration %q, expected e.g. 12h, 7d", str)
 		}
+		return time.Duration(days) * 24 * time.Hour, nil
 	}
-	return size
+	return time.ParseDuration(str)
 }
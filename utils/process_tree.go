@@ -0,0 +1,179 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// fields of /proc/<pid>/stat
+type procStat struct {
+	pid   int32
+	state byte
+	ppid  int32
+	pgid  int32
+}
+
+// read /proc/<pid>/stat of a process
+func readProcStat(pid string) (procStat, bool) {
+	content, err := os.ReadFile(filepath.Join("/proc", pid, "stat"))
+	if err != nil {
+		return procStat{}, false
+	}
+	// the command name is in parentheses and may contain spaces
+	stat := string(content)
+	end := strings.LastIndexByte(stat, ')')
+	if end < 0 {
+		return procStat{}, false
+	}
+	fields := strings.Fields(stat[end+1:])
+	if len(fields) < 4 {
+		return procStat{}, false
+	}
+	id, _ := strconv.Atoi(pid)
+	ppid, _ := strconv.Atoi(fields[1])
+	pgid, _ := strconv.Atoi(fields[2])
+	return procStat{pid: int32(id), state: fields[0][0], ppid: int32(ppid), pgid: int32(pgid)}, true
+}
+
+// read the stat of every process, false if /proc isn't available
+func readProcStats() ([]procStat, bool) {
+	entries, err := os.ReadDir("/proc")
+	if err != nil {
+		return nil, false
+	}
+	var stats []procStat
+	for _, entry := range entries {
+		if _, err := strconv.Atoi(entry.Name()); err != nil {
+			continue
+		}
+		if stat, ok := readProcStat(entry.Name()); ok {
+			stats = append(stats, stat)
+		}
+	}
+	return stats, true
+}
+
+// get the descendants of pid and the members of its process group, pid excluded
+func ProcessTree(pid int32) []int32 {
+	stats, ok := readProcStats()
+	if !ok {
+		return nil
+	}
+	children := make(map[int32][]int32)
+	for _, stat := range stats {
+		children[stat.ppid] = append(children[stat.ppid], stat.pid)
+	}
+
+	found := map[int32]bool{pid: true}
+	var tree []int32
+	add := func(p int32) bool {
+		if found[p] {
+			return false
+		}
+		found[p] = true
+		tree = append(tree, p)
+		return true
+	}
+	// orphans reparented to init are still in the process group
+	for _, stat := range stats {
+		if stat.pgid == pid {
+			add(stat.pid)
+		}
+	}
+	queue := append([]int32{pid}, tree...)
+	for len(queue) > 0 {
+		parent := queue[0]
+		queue = queue[1:]
+		for _, child := range children[parent] {
+			if add(child) {
+				queue = append(queue, child)
+			}
+		}
+	}
+	return tree
+}
+
+// clock ticks per second of the cpu times of /proc/<pid>/stat
+const clockTicks = 100
+
+// get the cpu time in seconds and the resident memory in bytes of a process from
+// /proc, false if it isn't available
+func ProcessUsage(pid int32) (float64, int64, bool) {
+	content, err := os.ReadFile(filepath.Join("/proc", strconv.Itoa(int(pid)), "stat"))
+	if err != nil {
+		return 0, 0, false
+	}
+	stat := string(content)
+	end := strings.LastIndexByte(stat, ')')
+	if end < 0 {
+		return 0, 0, false
+	}
+	// fields start at the state, the 3rd field of stat
+	fields := strings.Fields(stat[end+1:])
+	if len(fields) < 22 {
+		return 0, 0, false
+	}
+	utime, _ := strconv.ParseFloat(fields[11], 64)
+	stime, _ := strconv.ParseFloat(fields[12], 64)
+	rss, _ := strconv.ParseInt(fields[21], 10, 64)
+	return (utime + stime) / clockTicks, rss * int64(os.Getpagesize()), true
+}
+
+// check if a process is running, zombies waiting to be reaped aren't
+func IsProcessAlive(pid int32) bool {
+	if stat, ok := readProcStat(strconv.Itoa(int(pid))); ok {
+		return stat.state != 'Z' && stat.state != 'X'
+	}
+	if _, err := os.Stat("/proc/self/stat"); err == nil {
+		return false
+	}
+	_, running := IsProcessRunning(pid)
+	return running
+}
+
+// check if a process group has members left, zombies waiting to be reaped aren't counted
+func IsProcessGroupAlive(pgid int32) bool {
+	if err := syscall.Kill(-int(pgid), 0); err == syscall.ESRCH {
+		return false
+	}
+	stats, ok := readProcStats()
+	if !ok {
+		return true
+	}
+	for _, stat := range stats {
+		if stat.pgid == pgid && stat.state != 'Z' && stat.state != 'X' {
+			return true
+		}
+	}
+	return false
+}
+
+// wait for the processes to exit, returns the ones still alive after timeout
+func WaitForExit(pids []int32, timeout time.Duration) []int32 {
+	interval := 50 * time.Millisecond
+	for {
+		var alive []int32
+		for _, pid := range pids {
+			if IsProcessAlive(pid) {
+				alive = append(alive, pid)
+			}
+		}
+		if len(alive) == 0 || timeout <= 0 {
+			return alive
+		}
+		pids = alive
+		time.Sleep(interval)
+		timeout -= interval
+	}
+}
+
+// send a signal to processes, ignoring the ones which are gone
+func SignalProcesses(pids []int32, signal syscall.Signal) {
+	for _, pid := range pids {
+		syscall.Kill(int(pid), signal)
+	}
+}
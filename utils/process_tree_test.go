@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"os/exec"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestProcessTree(t *testing.T) {
+	cmd := exec.Command("/bin/sh", "-c", "sleep 30 & sleep 30 & wait")
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	pid := int32(cmd.Process.Pid)
+	defer syscall.Kill(-int(pid), syscall.SIGKILL)
+	go cmd.Wait()
+
+	var tree []int32
+	for i := 0; i < 40 && len(tree) < 2; i++ {
+		time.Sleep(50 * time.Millisecond)
+		tree = ProcessTree(pid)
+	}
+	if len(tree) != 2 {
+		t.Fatalf("expected 2 descendants, got %v", tree)
+	}
+
+	SignalProcesses(append(tree, pid), syscall.SIGKILL)
+	if remaining := WaitForExit(append(tree, pid), time.Second); len(remaining) > 0 {
+		t.Errorf("processes %v are still running", remaining)
+	}
+}
+
+func TestProcessUsage(t *testing.T) {
+	cmd := exec.Command("/bin/sh", "-c", "i=0; while [ $i -lt 200000 ]; do i=$((i+1)); done; sleep 30")
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer cmd.Process.Kill()
+	go cmd.Wait()
+
+	time.Sleep(300 * time.Millisecond)
+	cpu, memory, ok := ProcessUsage(int32(cmd.Process.Pid))
+	if !ok {
+		t.Skip("/proc isn't available")
+	}
+	if cpu <= 0 || memory <= 0 {
+		t.Errorf("unexpected usage cpu %v memory %d", cpu, memory)
+	}
+}
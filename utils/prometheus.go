@@ -0,0 +1,129 @@
+package utils
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// default buckets of histograms, in seconds
+var DefaultBuckets = []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// Label is a prometheus label, labels are written in the given order
+type Label struct {
+	Name  string
+	Value string
+}
+
+// MetricsWriter writes metrics in the prometheus text format
+type MetricsWriter struct {
+	w   io.Writer
+	err error
+}
+
+func NewMetricsWriter(w io.Writer) *MetricsWriter {
+	return &MetricsWriter{w: w}
+}
+
+// the first write error, later writes are skipped
+func (m *MetricsWriter) Err() error {
+	return m.err
+}
+
+func (m *MetricsWriter) printf(format string, args ...any) {
+	if m.err == nil {
+		_, m.err = fmt.Fprintf(m.w, format, args...)
+	}
+}
+
+// write the help and type of a metric family, kind is gauge, counter, histogram or summary
+func (m *MetricsWriter) Family(name string, kind string, help string) {
+	help = strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(help)
+	m.printf("# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+}
+
+// write a sample of a metric
+func (m *MetricsWriter) Sample(name string, labels []Label, value float64) {
+	m.printf("%s%s %s\n", name, formatLabels(labels), formatValue(value))
+}
+
+// write the buckets, sum and count of a histogram
+func (m *MetricsWriter) Histogram(name string, labels []Label, snapshot HistogramSnapshot) {
+	for i, bound := range snapshot.Buckets {
+		m.Sample(name+"_bucket", append(labels[:len(labels):len(labels)], Label{"le", formatValue(bound)}), float64(snapshot.Counts[i]))
+	}
+	m.Sample(name+"_bucket", append(labels[:len(labels):len(labels)], Label{"le", "+Inf"}), float64(snapshot.Count))
+	m.Sample(name+"_sum", labels, snapshot.Sum)
+	m.Sample(name+"_count", labels, float64(snapshot.Count))
+}
+
+func formatLabels(labels []Label) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	escape := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	parts := make([]string, len(labels))
+	for i, label := range labels {
+		parts[i] = fmt.Sprintf(`%s="%s"`, label.Name, escape.Replace(label.Value))
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
+func formatValue(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	case math.IsNaN(value):
+		return "NaN"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+// Histogram counts observations in cumulative buckets, it's safe for concurrent use
+type Histogram struct {
+	mu       sync.Mutex
+	snapshot HistogramSnapshot
+}
+
+type HistogramSnapshot struct {
+	Buckets []float64
+	// cumulative count of observations of each bucket
+	Counts []uint64
+	Sum    float64
+	Count  uint64
+}
+
+func NewHistogram(buckets []float64) *Histogram {
+	sorted := append([]float64(nil), buckets...)
+	sort.Float64s(sorted)
+	return &Histogram{snapshot: HistogramSnapshot{
+		Buckets: sorted,
+		Counts:  make([]uint64, len(sorted)),
+	}}
+}
+
+func (h *Histogram) Observe(value float64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for i, bound := range h.snapshot.Buckets {
+		if value <= bound {
+			h.snapshot.Counts[i]++
+		}
+	}
+	h.snapshot.Sum += value
+	h.snapshot.Count++
+}
+
+func (h *Histogram) Snapshot() HistogramSnapshot {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	snapshot := h.snapshot
+	snapshot.Counts = append([]uint64(nil), h.snapshot.Counts...)
+	return snapshot
+}
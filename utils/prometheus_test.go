@@ -0,0 +1,39 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMetricsWriter(t *testing.T) {
+	var out strings.Builder
+	m := NewMetricsWriter(&out)
+
+	m.Family("pm2_go_process_up", "gauge", "Whether the process is online.")
+	m.Sample("pm2_go_process_up", []Label{{"id", "0"}, {"name", `a "quoted"\name`}}, 1)
+
+	h := NewHistogram([]float64{1, 0.1})
+	h.Observe(0.05)
+	h.Observe(0.5)
+	h.Observe(2)
+	m.Family("pm2_go_rpc_duration_seconds", "histogram", "Duration of RPCs.")
+	m.Histogram("pm2_go_rpc_duration_seconds", []Label{{"method", "ListProcess"}}, h.Snapshot())
+
+	if err := m.Err(); err != nil {
+		t.Fatal(err)
+	}
+	expected := `# HELP pm2_go_process_up Whether the process is online.
+# TYPE pm2_go_process_up gauge
+pm2_go_process_up{id="0",name="a \"quoted\"\\name"} 1
+# HELP pm2_go_rpc_duration_seconds Duration of RPCs.
+# TYPE pm2_go_rpc_duration_seconds histogram
+pm2_go_rpc_duration_seconds_bucket{method="ListProcess",le="0.1"} 1
+pm2_go_rpc_duration_seconds_bucket{method="ListProcess",le="1"} 2
+pm2_go_rpc_duration_seconds_bucket{method="ListProcess",le="+Inf"} 3
+pm2_go_rpc_duration_seconds_sum{method="ListProcess"} 2.55
+pm2_go_rpc_duration_seconds_count{method="ListProcess"} 3
+`
+	if out.String() != expected {
+		t.Errorf("unexpected output:\n%s", out.String())
+	}
+}
@@ -0,0 +1,128 @@
+package utils
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	LogRotateModeCopyTruncate = "copytruncate"
+	LogRotateModeReopen       = "reopen"
+
+	DefaultLogRotateDateFormat = "2006-01-02_15-04-05"
+)
+
+// split a log file path into its stem and extension, e.g. api-out & .log
+func splitLogFilePath(filePath string) (string, string) {
+	ext := filepath.Ext(filePath)
+	return strings.TrimSuffix(filePath, ext), ext
+}
+
+// get the date-stamped path of a rotated log file, e.g. api-out__2022-10-19_00-00-00.log
+func RotatedFilePath(filePath string, now time.Time, dateFormat string, compress bool) string {
+	stem, ext := splitLogFilePath(filePath)
+	rotatedFilePath := stem + "__" + now.Format(dateFormat) + ext
+	suffix := ""
+	if compress {
+		suffix = ".gz"
+	}
+	// don't overwrite a file rotated within the same date stamp
+	candidate := rotatedFilePath + suffix
+	for i := 1; ; i++ {
+		if _, err := os.Stat(candidate); os.IsNotExist(err) {
+			return candidate
+		}
+		candidate = stem + "__" + now.Format(dateFormat) + "." + strconv.Itoa(i) + ext + suffix
+	}
+}
+
+// get rotated files of a log file, oldest first
+func RotatedFiles(filePath string) []string {
+	stem, ext := splitLogFilePath(filePath)
+	matches, err := filepath.Glob(stem + "__*" + ext + "*")
+	if err != nil {
+		return nil
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		return GetLastModified(matches[i]).Before(GetLastModified(matches[j]))
+	})
+	return matches
+}
+
+// copy src into dst, compressing it with gzip if asked
+func copyLogFile(src string, dst string, compress bool) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0640)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	if !compress {
+		_, err = io.Copy(out, in)
+		return err
+	}
+	writer := gzip.NewWriter(out)
+	if _, err := io.Copy(writer, in); err != nil {
+		return err
+	}
+	return writer.Close()
+}
+
+// rotate a log file into rotatedFilePath
+//
+// with reopen, the log file is renamed and reopen is expected to move the writes to
+// a new file. if reopen is nil, the log file is copied and truncated in place, as
+// the process keeps writing into the same file
+func RotateFile(filePath string, rotatedFilePath string, compress bool, reopen func() error) error {
+	if reopen == nil {
+		if err := copyLogFile(filePath, rotatedFilePath, compress); err != nil {
+			return err
+		}
+		return os.Truncate(filePath, 0)
+	}
+
+	renamedFilePath := strings.TrimSuffix(rotatedFilePath, ".gz")
+	if err := os.Rename(filePath, renamedFilePath); err != nil {
+		return err
+	}
+	if err := reopen(); err != nil {
+		return err
+	}
+	if !compress {
+		return nil
+	}
+	if err := copyLogFile(renamedFilePath, rotatedFilePath, true); err != nil {
+		return err
+	}
+	return os.Remove(renamedFilePath)
+}
+
+// delete rotated files beyond maxFiles or older than maxAge, zero disables the limit.
+// returns the deleted files
+func PruneRotatedFiles(filePath string, maxFiles int, maxAge time.Duration) []string {
+	var deleted []string
+	rotatedFiles := RotatedFiles(filePath)
+	for i, rotatedFile := range rotatedFiles {
+		tooMany := maxFiles > 0 && len(rotatedFiles)-i > maxFiles
+		tooOld := maxAge > 0 && time.Since(GetLastModified(rotatedFile)) > maxAge
+		if !tooMany && !tooOld {
+			continue
+		}
+		if err := os.Remove(rotatedFile); err == nil {
+			deleted = append(deleted, rotatedFile)
+		}
+	}
+	return deleted
+}
@@ -0,0 +1,90 @@
+package utils
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRotateFileCopyTruncate(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "app-out.log")
+	if err := os.WriteFile(logFile, []byte("hello\n"), 0640); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Date(2022, 10, 19, 0, 0, 0, 0, time.UTC)
+	rotatedFile := RotatedFilePath(logFile, now, DefaultLogRotateDateFormat, true)
+	if filepath.Base(rotatedFile) != "app-out__2022-10-19_00-00-00.log.gz" {
+		t.Fatalf("unexpected rotated file %s", rotatedFile)
+	}
+	if err := RotateFile(logFile, rotatedFile, true, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if size := FileSize(logFile); size != 0 {
+		t.Errorf("log file was not truncated, size %d", size)
+	}
+	f, err := os.Open(rotatedFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	reader, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, _ := io.ReadAll(reader)
+	if string(content) != "hello\n" {
+		t.Errorf("unexpected rotated content %q", content)
+	}
+
+	// same date stamp doesn't overwrite the rotated file
+	if next := RotatedFilePath(logFile, now, DefaultLogRotateDateFormat, true); next == rotatedFile {
+		t.Errorf("rotated file %s would be overwritten", next)
+	}
+}
+
+func TestRotateFileReopen(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "app-out.log")
+	os.WriteFile(logFile, []byte("hello\n"), 0640)
+
+	rotatedFile := RotatedFilePath(logFile, time.Now(), DefaultLogRotateDateFormat, false)
+	reopened := false
+	err := RotateFile(logFile, rotatedFile, false, func() error {
+		reopened = true
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reopened {
+		t.Error("log file was not reopened")
+	}
+	if _, err := os.Stat(logFile); !os.IsNotExist(err) {
+		t.Error("log file was not renamed")
+	}
+}
+
+func TestPruneRotatedFiles(t *testing.T) {
+	dir := t.TempDir()
+	logFile := filepath.Join(dir, "app-out.log")
+	for i, name := range []string{"app-out__1.log.gz", "app-out__2.log", "app-out__3.log", "app-out__4.log"} {
+		rotatedFile := filepath.Join(dir, name)
+		os.WriteFile(rotatedFile, nil, 0640)
+		modTime := time.Now().Add(-time.Duration(4-i) * time.Hour)
+		os.Chtimes(rotatedFile, modTime, modTime)
+	}
+
+	// the oldest is too old, the next one exceeds the max files
+	deleted := PruneRotatedFiles(logFile, 2, 210*time.Minute)
+	if len(deleted) != 2 {
+		t.Fatalf("expected 2 deleted files, got %v", deleted)
+	}
+	remaining := RotatedFiles(logFile)
+	if len(remaining) != 2 || filepath.Base(remaining[0]) != "app-out__3.log" {
+		t.Errorf("unexpected remaining files %v", remaining)
+	}
+}
@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// signals by name, without the SIG prefix
+var signals = map[string]syscall.Signal{
+	"HUP":    syscall.SIGHUP,
+	"INT":    syscall.SIGINT,
+	"QUIT":   syscall.SIGQUIT,
+	"ILL":    syscall.SIGILL,
+	"TRAP":   syscall.SIGTRAP,
+	"ABRT":   syscall.SIGABRT,
+	"BUS":    syscall.SIGBUS,
+	"FPE":    syscall.SIGFPE,
+	"KILL":   syscall.SIGKILL,
+	"USR1":   syscall.SIGUSR1,
+	"SEGV":   syscall.SIGSEGV,
+	"USR2":   syscall.SIGUSR2,
+	"PIPE":   syscall.SIGPIPE,
+	"ALRM":   syscall.SIGALRM,
+	"TERM":   syscall.SIGTERM,
+	"CHLD":   syscall.SIGCHLD,
+	"CONT":   syscall.SIGCONT,
+	"STOP":   syscall.SIGSTOP,
+	"TSTP":   syscall.SIGTSTP,
+	"TTIN":   syscall.SIGTTIN,
+	"TTOU":   syscall.SIGTTOU,
+	"URG":    syscall.SIGURG,
+	"XCPU":   syscall.SIGXCPU,
+	"XFSZ":   syscall.SIGXFSZ,
+	"VTALRM": syscall.SIGVTALRM,
+	"PROF":   syscall.SIGPROF,
+	"WINCH":  syscall.SIGWINCH,
+	"IO":     syscall.SIGIO,
+	"SYS":    syscall.SIGSYS,
+}
+
+// SIGHUP, HUP, sighup or 1
+func ParseSignal(str string) (syscall.Signal, error) {
+	if number, err := strconv.Atoi(str); err == nil {
+		if number <= 0 || number > 64 {
+			return 0, fmt.Errorf("invalid signal number %d", number)
+		}
+		return syscall.Signal(number), nil
+	}
+	name := strings.TrimPrefix(strings.ToUpper(str), "SIG")
+	if signal, ok := signals[name]; ok {
+		return signal, nil
+	}
+	return 0, fmt.Errorf("unknown signal %q", str)
+}
+
+// get the name of a signal, e.g. SIGHUP
+func SignalName(signal syscall.Signal) string {
+	for name, s := range signals {
+		if s == signal {
+			return "SIG" + name
+		}
+	}
+	return strconv.Itoa(int(signal))
+}
@@ -0,0 +1,28 @@
+package utils
+
+import (
+	"syscall"
+	"testing"
+)
+
+func TestParseSignal(t *testing.T) {
+	for str, expected := range map[string]syscall.Signal{
+		"SIGHUP":  syscall.SIGHUP,
+		"usr1":    syscall.SIGUSR1,
+		"sigterm": syscall.SIGTERM,
+		"9":       syscall.SIGKILL,
+	} {
+		signal, err := ParseSignal(str)
+		if err != nil || signal != expected {
+			t.Errorf("%s: expected %v, got %v %v", str, expected, signal, err)
+		}
+	}
+	for _, str := range []string{"", "SIGNOPE", "0", "99"} {
+		if _, err := ParseSignal(str); err == nil {
+			t.Errorf("expected an error for %q", str)
+		}
+	}
+	if name := SignalName(syscall.SIGUSR2); name != "SIGUSR2" {
+		t.Errorf("unexpected name %s", name)
+	}
+}
@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+)
+
+// get the path of the api token of the daemon
+func TokenFilePath() string {
+	return path.Join(GetMainDirectory(), "token")
+}
+
+// get the path of the read-only token of the daemon, it can only list and describe
+func ReadOnlyTokenFilePath() string {
+	return path.Join(GetMainDirectory(), "token.readonly")
+}
+
+// read the token of a file
+func ReadToken(filename string) (string, error) {
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return "", err
+	}
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return "", fmt.Errorf("token file %s is empty", filename)
+	}
+	return token, nil
+}
+
+// read the token of a file, creating it with a random token readable by the owner only
+func LoadOrCreateToken(filename string) (string, error) {
+	token, err := ReadToken(filename)
+	if !errors.Is(err, os.ErrNotExist) {
+		return token, err
+	}
+
+	random := make([]byte, 32)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	token = hex.EncodeToString(random)
+	file, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		// created meanwhile
+		if errors.Is(err, os.ErrExist) {
+			return LoadOrCreateToken(filename)
+		}
+		return "", err
+	}
+	defer file.Close()
+	if _, err := file.WriteString(token + "\n"); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// compare tokens in constant time
+func TokenEqual(a string, b string) bool {
+	return a != "" && subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}